@@ -0,0 +1,20 @@
+# Export the last day of audit logs to a local NDJSON file
+data "astronomer_audit_logs" "last_day" {
+  start_date  = timeadd(plantimestamp(), "-24h")
+  end_date    = plantimestamp()
+  output_file = "${path.module}/audit-logs.ndjson"
+}
+
+# Or read the events directly
+data "astronomer_audit_logs" "january" {
+  start_date = "2024-01-01T00:00:00Z"
+  end_date   = "2024-02-01T00:00:00Z"
+}
+
+output "january_event_count" {
+  value = data.astronomer_audit_logs.january.event_count
+}
+
+output "january_events" {
+  value = [for event in data.astronomer_audit_logs.january.events : jsondecode(event)]
+}
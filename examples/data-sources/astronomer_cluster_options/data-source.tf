@@ -0,0 +1,12 @@
+data "astronomer_cluster_options" "dedicated" {
+  type = "DEDICATED"
+}
+
+locals {
+  cluster_options = { for provider in data.astronomer_cluster_options.dedicated.providers : provider.cloud_provider => provider }
+  aws_regions     = [for region in local.cluster_options["AWS"].regions : region.name]
+}
+
+output "aws_regions" {
+  value = local.aws_regions
+}
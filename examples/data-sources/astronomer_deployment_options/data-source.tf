@@ -0,0 +1,20 @@
+data "astronomer_deployment_options" "standard_aws" {
+  cloud_provider  = "AWS"
+  deployment_type = "STANDARD"
+  executor        = "CELERY"
+}
+
+variable "astro_machine" {
+  type    = string
+  default = "A5"
+}
+
+# Catch typos in module inputs before apply
+resource "terraform_data" "check_astro_machine" {
+  lifecycle {
+    precondition {
+      condition     = contains(data.astronomer_deployment_options.standard_aws.astro_machines, var.astro_machine)
+      error_message = "astro_machine must be one of ${join(", ", data.astronomer_deployment_options.standard_aws.astro_machines)}."
+    }
+  }
+}
@@ -0,0 +1,3 @@
+data "astronomer_permission_groups" "deployment" {
+  scope_type = "DEPLOYMENT"
+}
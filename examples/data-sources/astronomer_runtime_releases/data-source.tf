@@ -0,0 +1,17 @@
+# The latest 11.x release
+data "astronomer_runtime_releases" "runtime_11" {
+  version_constraint = "~> 11.0"
+  latest_only        = true
+}
+
+resource "astronomer_deployment" "example" {
+  # ...
+  astro_runtime_version = data.astronomer_runtime_releases.runtime_11.latest_version
+}
+
+# Every release still in support
+data "astronomer_runtime_releases" "all" {}
+
+output "supported_releases" {
+  value = [for release in data.astronomer_runtime_releases.all.releases : release.version if release.channel != "deprecated"]
+}
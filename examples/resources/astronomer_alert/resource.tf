@@ -0,0 +1,39 @@
+resource "astronomer_alert" "etl_failure" {
+  name          = "ETL failure"
+  type          = "DAG_FAILURE"
+  severity      = "CRITICAL"
+  deployment_id = astronomer_deployment.standard_deployment.id
+  notification_channel_ids = [
+    astronomer_notification_channel.on_call_email.id,
+    astronomer_notification_channel.slack.id,
+  ]
+  rules = {
+    pattern_matches = [
+      {
+        entity_type : "DAG_ID",
+        operator_type : "INCLUDES",
+        values : ["etl_"],
+      },
+    ]
+  }
+}
+
+resource "astronomer_alert" "etl_late" {
+  name                     = "ETL not done by 6am"
+  type                     = "DAG_TIMELINESS"
+  severity                 = "WARNING"
+  deployment_id            = astronomer_deployment.standard_deployment.id
+  notification_channel_ids = [astronomer_notification_channel.slack.id]
+  rules = {
+    dag_deadline             = "06:00"
+    days_of_week             = ["MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY"]
+    look_back_period_seconds = 86400
+    pattern_matches = [
+      {
+        entity_type : "DAG_ID",
+        operator_type : "IS",
+        values : ["etl_daily"],
+      },
+    ]
+  }
+}
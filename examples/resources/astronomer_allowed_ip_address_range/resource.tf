@@ -0,0 +1,14 @@
+variable "corporate_egress_ranges" {
+  type = map(string)
+  default = {
+    "Office egress"    = "203.0.113.0/24"
+    "Corporate VPN"    = "198.51.100.0/25"
+    "CI runners (NAT)" = "192.0.2.16/28"
+  }
+}
+
+resource "astronomer_allowed_ip_address_range" "corporate" {
+  for_each         = var.corporate_egress_ranges
+  ip_address_range = each.value
+  description      = each.key
+}
@@ -0,0 +1,6 @@
+resource "astronomer_cluster_node_pool" "gpu" {
+  cluster_id         = astronomer_cluster.aws_dedicated.id
+  name               = "gpu"
+  max_node_count     = 4
+  node_instance_type = "g5.xlarge"
+}
@@ -0,0 +1,10 @@
+resource "astronomer_cluster_private_endpoint" "warehouse" {
+  cluster_id   = astronomer_cluster.aws_dedicated.id
+  service_name = aws_vpc_endpoint_service.warehouse.service_name
+}
+
+# Accept the connection on our endpoint service
+resource "aws_vpc_endpoint_connection_accepter" "astro" {
+  vpc_endpoint_service_id = aws_vpc_endpoint_service.warehouse.id
+  vpc_endpoint_id         = astronomer_cluster_private_endpoint.warehouse.endpoint_id
+}
@@ -0,0 +1,6 @@
+# Route traffic for the peered VPC through the peering connection
+resource "astronomer_cluster_route" "shared_services" {
+  cluster_id       = astronomer_cluster.aws_dedicated.id
+  destination_cidr = "10.100.0.0/16"
+  target_id        = aws_vpc_peering_connection_accepter.astro.vpc_peering_connection_id
+}
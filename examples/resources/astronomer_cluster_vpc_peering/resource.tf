@@ -0,0 +1,24 @@
+resource "astronomer_cluster_vpc_peering" "shared_services" {
+  cluster_id      = astronomer_cluster.aws_dedicated.id
+  peer_account_id = "123456789012"
+  peer_network_id = "vpc-0a1b2c3d4e5f67890"
+  peer_region     = "us-east-1"
+}
+
+# Accept the peering in our own AWS account
+resource "aws_vpc_peering_connection_accepter" "astro" {
+  vpc_peering_connection_id = astronomer_cluster_vpc_peering.shared_services.peering_id
+  auto_accept               = true
+}
+
+resource "astronomer_cluster_vpc_peering" "gcp_shared_services" {
+  cluster_id      = astronomer_cluster.gcp_dedicated.id
+  peer_project_id = "shared-services"
+  peer_network_id = "shared-vpc"
+}
+
+resource "google_compute_network_peering" "astro" {
+  name         = "astro"
+  network      = "projects/shared-services/global/networks/shared-vpc"
+  peer_network = "projects/${astronomer_cluster_vpc_peering.gcp_shared_services.astro_project_id}/global/networks/${astronomer_cluster_vpc_peering.gcp_shared_services.astro_network_id}"
+}
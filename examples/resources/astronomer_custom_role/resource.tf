@@ -0,0 +1,17 @@
+resource "astronomer_custom_role" "ci_deployer" {
+  name        = "CI deployer"
+  description = "Can deploy code and read the Deployment, nothing else"
+  scope_type  = "DEPLOYMENT"
+  permissions = [
+    "deployment.get",
+    "deployment.images.push",
+    "deployment.dags.push",
+  ]
+  restricted_workspace_ids = [astronomer_workspace.complete_setup.id]
+}
+
+resource "astronomer_deployment_api_token" "ci_deployer" {
+  deployment_id = astronomer_deployment.standard_deployment.id
+  name          = "CI deployer"
+  role          = astronomer_custom_role.ci_deployer.id
+}
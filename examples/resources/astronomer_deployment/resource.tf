@@ -15,26 +15,63 @@ resource "astronomer_deployment" "standard_deployment" {
   scheduler_size          = "MEDIUM"
   type                    = "STANDARD"
   workspace_id            = astronomer_workspace.complete_setup.id
-  worker_queues = [
-    {
+  worker_queues = {
+    default = {
       astro_machine : "A5",
       is_default : true,
       max_worker_count : 1,
       min_worker_count : 1,
-      name : "default",
       worker_concurrency : 1,
     },
-  ]
-  environment_variables = [
-    {
-      is_secret : true,
-      key : "AWS_ACCESS_SECRET_KEY",
-      value : "SECRET_VALUE",
-    },
-    {
-      is_secret : false,
-      key : "AWS_ACCESS_KEY_ID",
-      value : "NOT_SECRET",
+  }
+  environment_variables = {
+    AWS_ACCESS_SECRET_KEY = {
+      is_secret        = true
+      value_wo         = "SECRET_VALUE"
+      value_wo_version = 1
+    }
+    AWS_ACCESS_KEY_ID = {
+      is_secret = false
+      value     = "NOT_SECRET"
+    }
+  }
+}
+resource "astronomer_deployment" "development_deployment" {
+  astro_runtime_version   = "9.1.0"
+  cloud_provider          = "AWS"
+  default_task_pod_cpu    = "0.5"
+  default_task_pod_memory = "1Gi"
+  description             = "A Development Deployment that sleeps at night"
+  executor                = "CELERY"
+  is_dag_deploy_enabled   = true
+  is_cicd_enforced        = true
+  is_development_mode     = true
+  is_high_availability    = false
+  name                    = "Development Deployment TF"
+  region                  = "us-east-1"
+  resource_quota_cpu      = "10"
+  resource_quota_memory   = "20Gi"
+  scheduler_size          = "SMALL"
+  type                    = "STANDARD"
+  workspace_id            = astronomer_workspace.complete_setup.id
+  worker_queues = {
+    default = {
+      astro_machine : "A5",
+      is_default : true,
+      max_worker_count : 1,
+      min_worker_count : 0,
+      worker_concurrency : 5,
     },
-  ]
-}
\ No newline at end of file
+  }
+  scaling_spec = {
+    hibernation_spec = {
+      schedules = [
+        {
+          description : "Hibernate outside of working hours",
+          hibernate_at_cron : "0 20 * * 1-5",
+          wake_at_cron : "0 8 * * 1-5",
+        },
+      ]
+    }
+  }
+}
@@ -0,0 +1,13 @@
+resource "astronomer_deployment_api_token" "ci" {
+  deployment_id          = astronomer_deployment.standard_deployment.id
+  name                   = "CI deploys"
+  description            = "Used by the CI pipeline to deploy DAGs"
+  role                   = "DEPLOYMENT_ADMIN"
+  expiry_period_in_days  = 90
+  renewal_window_in_days = 14
+}
+
+output "ci_deploy_token" {
+  value     = astronomer_deployment_api_token.ci.token
+  sensitive = true
+}
@@ -0,0 +1,15 @@
+resource "astronomer_deployment_environment_variable" "snowflake_account" {
+  deployment_id = astronomer_deployment.standard_deployment.id
+  key           = "SNOWFLAKE_ACCOUNT"
+  value         = "gkconsulting"
+  is_secret     = false
+}
+
+resource "astronomer_deployment_environment_variable" "snowflake_password" {
+  deployment_id = astronomer_deployment.standard_deployment.id
+  key           = "SNOWFLAKE_PASSWORD"
+  # Kept out of the Terraform state, bump value_wo_version to send a rotated password
+  value_wo         = var.snowflake_password
+  value_wo_version = 1
+  is_secret        = true
+}
@@ -0,0 +1,5 @@
+resource "astronomer_deployment_hibernation_override" "holiday" {
+  deployment_id  = astronomer_deployment.development_deployment.id
+  is_hibernating = true
+  override_until = "2025-01-02T08:00:00Z"
+}
@@ -0,0 +1,8 @@
+resource "astronomer_deployment_worker_queue" "ml_training" {
+  deployment_id      = astronomer_deployment.standard_deployment.id
+  name               = "ml-training"
+  astro_machine      = "A10"
+  max_worker_count   = 4
+  min_worker_count   = 0
+  worker_concurrency = 2
+}
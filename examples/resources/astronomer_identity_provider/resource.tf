@@ -0,0 +1,8 @@
+resource "astronomer_identity_provider" "okta" {
+  type               = "SAML"
+  managed_domain_ids = [astronomer_managed_domain.company.id]
+  saml_connection = {
+    auth_request_url = "https://gkconsulting.okta.com/app/astronomer/sso/saml"
+    signing_cert     = file("${path.module}/okta.pem")
+  }
+}
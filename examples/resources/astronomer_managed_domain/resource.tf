@@ -0,0 +1,4 @@
+resource "astronomer_managed_domain" "company" {
+  name            = "gkconsulting.dev"
+  enforced_logins = ["SSO"]
+}
@@ -0,0 +1,27 @@
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+}
+
+resource "astronomer_notification_channel" "on_call_email" {
+  name        = "On-call email"
+  type        = "EMAIL"
+  entity_type = "DEPLOYMENT"
+  entity_id   = astronomer_deployment.standard_deployment.id
+  definition = {
+    recipients = ["oncall@example.com"]
+  }
+}
+
+resource "astronomer_notification_channel" "slack" {
+  name        = "Data team Slack"
+  type        = "SLACK"
+  entity_type = "WORKSPACE"
+  entity_id   = astronomer_workspace.complete_setup.id
+  is_shared   = true
+  definition = {
+    # Kept out of the Terraform state, bump webhook_url_version to send a new URL
+    webhook_url         = var.slack_webhook_url
+    webhook_url_version = 1
+  }
+}
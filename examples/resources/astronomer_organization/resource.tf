@@ -0,0 +1,11 @@
+resource "astronomer_organization" "current" {
+  name                                 = "GK Consulting"
+  billing_email                        = "billing@gkconsulting.dev"
+  environment_secrets_fetching_enabled = false
+
+  default_deployment_settings = {
+    is_cicd_enforced      = true
+    is_dag_deploy_enabled = true
+    is_high_availability  = false
+  }
+}
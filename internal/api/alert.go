@@ -0,0 +1,159 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	AlertTypeDagDuration   = "DAG_DURATION"
+	AlertTypeDagFailure    = "DAG_FAILURE"
+	AlertTypeDagSuccess    = "DAG_SUCCESS"
+	AlertTypeDagTimeliness = "DAG_TIMELINESS"
+	AlertTypeTaskDuration  = "TASK_DURATION"
+	AlertTypeTaskFailure   = "TASK_FAILURE"
+)
+
+const (
+	AlertSeverityCritical = "CRITICAL"
+	AlertSeverityInfo     = "INFO"
+	AlertSeverityWarning  = "WARNING"
+)
+
+const (
+	AlertEntityTypeDeployment = "DEPLOYMENT"
+)
+
+const (
+	AlertPatternMatchEntityTypeDagId  = "DAG_ID"
+	AlertPatternMatchEntityTypeTaskId = "TASK_ID"
+)
+
+const (
+	AlertPatternMatchOperatorExcludes = "EXCLUDES"
+	AlertPatternMatchOperatorIncludes = "INCLUDES"
+	AlertPatternMatchOperatorIs       = "IS"
+	AlertPatternMatchOperatorIsNot    = "IS_NOT"
+)
+
+type AlertPatternMatch struct {
+	EntityType   string   `json:"entityType"`
+	OperatorType string   `json:"operatorType"`
+	Values       []string `json:"values"`
+}
+
+// AlertRulesProperties holds the properties of every alert type, only the ones of the alert's type
+// are set.
+type AlertRulesProperties struct {
+	DagDeadline           string   `json:"dagDeadline,omitempty"`
+	DagDurationSeconds    int      `json:"dagDurationSeconds,omitempty"`
+	DaysOfWeek            []string `json:"daysOfWeek,omitempty"`
+	DeploymentId          string   `json:"deploymentId"`
+	LookBackPeriodSeconds int      `json:"lookBackPeriodSeconds,omitempty"`
+	TaskDurationSeconds   int      `json:"taskDurationSeconds,omitempty"`
+}
+
+type AlertRules struct {
+	PatternMatches []AlertPatternMatch  `json:"patternMatches"`
+	Properties     AlertRulesProperties `json:"properties"`
+}
+
+type AlertNotificationChannel struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type AlertResponse struct {
+	CreatedAt            string                      `json:"createdAt"`
+	CreatedBy            BasicSubjectProfileResponse `json:"createdBy"`
+	DeploymentId         string                      `json:"deploymentId"`
+	EntityId             string                      `json:"entityId"`
+	EntityName           string                      `json:"entityName"`
+	EntityType           string                      `json:"entityType"`
+	Id                   string                      `json:"id"`
+	Name                 string                      `json:"name"`
+	NotificationChannels []AlertNotificationChannel  `json:"notificationChannels"`
+	OrganizationId       string                      `json:"organizationId"`
+	Rules                AlertRules                  `json:"rules"`
+	Severity             string                      `json:"severity"`
+	Type                 string                      `json:"type"`
+	UpdatedAt            string                      `json:"updatedAt"`
+	UpdatedBy            BasicSubjectProfileResponse `json:"updatedBy"`
+	WorkspaceId          string                      `json:"workspaceId"`
+}
+
+type AlertCreateRequest struct {
+	EntityId               string     `json:"entityId"`
+	EntityType             string     `json:"entityType"`
+	Name                   string     `json:"name"`
+	NotificationChannelIds []string   `json:"notificationChannelIds"`
+	Rules                  AlertRules `json:"rules"`
+	Severity               string     `json:"severity"`
+	Type                   string     `json:"type"`
+}
+
+type AlertUpdateRequest struct {
+	Name                   string     `json:"name"`
+	NotificationChannelIds []string   `json:"notificationChannelIds"`
+	Rules                  AlertRules `json:"rules"`
+	Severity               string     `json:"severity"`
+	Type                   string     `json:"type"`
+}
+
+func GetAlert(apiKey string, organizationId string, alertId string) (*AlertResponse, error) {
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/alerts/"+alertId, nil)
+	decoded := new(AlertResponse)
+	err := getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func CreateAlert(apiKey string, organizationId string, createRequest *AlertCreateRequest) (*AlertResponse, error) {
+	b, err := json.Marshal(createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/alerts", bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(AlertResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func UpdateAlert(apiKey string, organizationId string, alertId string, updateRequest *AlertUpdateRequest) (*AlertResponse, error) {
+	if alertId == "" {
+		return nil, fmt.Errorf("No Alert ID Given.")
+	}
+	b, err := json.Marshal(updateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/alerts/"+alertId, bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(AlertResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func DeleteAlert(apiKey string, organizationId string, alertId string) error {
+	request, _ := http.NewRequest("DELETE", urlBase+organizationId+"/alerts/"+alertId, nil)
+	_, httpErr := makeAuthorizedRequest(request, apiKey)
+
+	if httpErr != nil {
+		return fmt.Errorf("Delete Error: %s", httpErr)
+	}
+	return nil
+}
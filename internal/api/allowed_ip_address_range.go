@@ -0,0 +1,88 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type AllowedIpAddressRangeListResponse struct {
+	AllowedIpAddressRanges []AllowedIpAddressRangeResponse `json:"allowedIpAddressRanges"`
+	Limit                  int                             `json:"limit"`
+	Offset                 int                             `json:"offset"`
+	TotalCount             int                             `json:"totalCount"`
+}
+
+type AllowedIpAddressRangeResponse struct {
+	CreatedAt      string                      `json:"createdAt"`
+	CreatedBy      BasicSubjectProfileResponse `json:"createdBy"`
+	Description    string                      `json:"description"`
+	Id             string                      `json:"id"`
+	IpAddressRange string                      `json:"ipAddressRange"`
+	OrganizationId string                      `json:"organizationId"`
+	UpdatedAt      string                      `json:"updatedAt"`
+	UpdatedBy      BasicSubjectProfileResponse `json:"updatedBy"`
+}
+
+type AllowedIpAddressRangeCreateRequest struct {
+	Description    string `json:"description,omitempty"`
+	IpAddressRange string `json:"ipAddressRange"`
+}
+
+func GetAllowedIpAddressRanges(apiKey string, organizationId string) ([]AllowedIpAddressRangeResponse, error) {
+	var ranges []AllowedIpAddressRangeResponse = []AllowedIpAddressRangeResponse{}
+	for {
+		request, _ := http.NewRequest("GET", urlBase+organizationId+fmt.Sprintf("/allowed-ip-address-ranges?offset=%d", len(ranges)), nil)
+		decoded := new(AllowedIpAddressRangeListResponse)
+		err := getObjectFromApi(apiKey, request, &decoded)
+		if err != nil {
+			return nil, fmt.Errorf("%s", err)
+		}
+		ranges = append(ranges, decoded.AllowedIpAddressRanges...)
+		if len(decoded.AllowedIpAddressRanges) == 0 || len(ranges) >= decoded.TotalCount {
+			return ranges, nil
+		}
+	}
+}
+
+// GetAllowedIpAddressRange returns the allowed IP address range with the given ID, or nil if
+// there is none. The API only lists ranges, so this searches the organization's allow-list.
+func GetAllowedIpAddressRange(apiKey string, organizationId string, allowedIpAddressRangeId string) (*AllowedIpAddressRangeResponse, error) {
+	ranges, err := GetAllowedIpAddressRanges(apiKey, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	for _, ipRange := range ranges {
+		if ipRange.Id == allowedIpAddressRangeId {
+			return &ipRange, nil
+		}
+	}
+	return nil, nil
+}
+
+func CreateAllowedIpAddressRange(apiKey string, organizationId string, createRequest *AllowedIpAddressRangeCreateRequest) (*AllowedIpAddressRangeResponse, error) {
+	b, err := json.Marshal(createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/allowed-ip-address-ranges", bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(AllowedIpAddressRangeResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func DeleteAllowedIpAddressRange(apiKey string, organizationId string, allowedIpAddressRangeId string) error {
+	request, _ := http.NewRequest("DELETE", urlBase+organizationId+"/allowed-ip-address-ranges/"+allowedIpAddressRangeId, nil)
+	_, httpErr := makeAuthorizedRequest(request, apiKey)
+
+	if httpErr != nil {
+		return fmt.Errorf("Delete Error: %s", httpErr)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ApiTokenTypeDeployment   = "DEPLOYMENT"
+	ApiTokenTypeOrganization = "ORGANIZATION"
+	ApiTokenTypeWorkspace    = "WORKSPACE"
+)
+
+const (
+	DeploymentRoleAdmin = "DEPLOYMENT_ADMIN"
+)
+
+type ApiTokenRole struct {
+	EntityId   string `json:"entityId"`
+	EntityType string `json:"entityType"`
+	Role       string `json:"role"`
+}
+
+type ApiTokenResponse struct {
+	CreatedAt          string                      `json:"createdAt"`
+	CreatedBy          BasicSubjectProfileResponse `json:"createdBy"`
+	Description        string                      `json:"description"`
+	EndAt              string                      `json:"endAt"`
+	ExpiryPeriodInDays int                         `json:"expiryPeriodInDays"`
+	Id                 string                      `json:"id"`
+	LastUsedAt         string                      `json:"lastUsedAt"`
+	Name               string                      `json:"name"`
+	Roles              []ApiTokenRole              `json:"roles"`
+	ShortToken         string                      `json:"shortToken"`
+	StartAt            string                      `json:"startAt"`
+	// Token is only returned when the token is created or rotated
+	Token     string                      `json:"token"`
+	Type      string                      `json:"type"`
+	UpdatedAt string                      `json:"updatedAt"`
+	UpdatedBy BasicSubjectProfileResponse `json:"updatedBy"`
+}
+
+type ApiTokenCreateRequest struct {
+	Description             string `json:"description"`
+	EntityId                string `json:"entityId"`
+	Name                    string `json:"name"`
+	Role                    string `json:"role"`
+	TokenExpiryPeriodInDays int    `json:"tokenExpiryPeriodInDays,omitempty"`
+	Type                    string `json:"type"`
+}
+
+type ApiTokenUpdateRequest struct {
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+func GetApiToken(apiKey string, organizationId string, apiTokenId string) (*ApiTokenResponse, error) {
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/tokens/"+apiTokenId, nil)
+	decoded := new(ApiTokenResponse)
+	err := getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func CreateApiToken(apiKey string, organizationId string, createRequest *ApiTokenCreateRequest) (*ApiTokenResponse, error) {
+	b, err := json.Marshal(createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/tokens", bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(ApiTokenResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func UpdateApiToken(apiKey string, organizationId string, apiTokenId string, updateRequest *ApiTokenUpdateRequest) (*ApiTokenResponse, error) {
+	if apiTokenId == "" {
+		return nil, fmt.Errorf("No API Token ID Given.")
+	}
+	b, err := json.Marshal(updateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/tokens/"+apiTokenId, bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(ApiTokenResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func UpdateApiTokenRole(apiKey string, organizationId string, apiTokenId string, roleRequest *ApiTokenRole) error {
+	if apiTokenId == "" {
+		return fmt.Errorf("No API Token ID Given.")
+	}
+	b, err := json.Marshal(roleRequest)
+	if err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/tokens/"+apiTokenId+"/roles", bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(ApiTokenRole)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	return nil
+}
+
+func DeleteApiToken(apiKey string, organizationId string, apiTokenId string) error {
+	request, _ := http.NewRequest("DELETE", urlBase+organizationId+"/tokens/"+apiTokenId, nil)
+	_, httpErr := makeAuthorizedRequest(request, apiKey)
+
+	if httpErr != nil {
+		return fmt.Errorf("Delete Error: %s", httpErr)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// GetAuditLogs returns the organization's audit log events between the two RFC3339 dates,
+// one JSON document per event. The API responds with NDJSON rather than a single object,
+// so the body can't go through getObjectFromApi.
+func GetAuditLogs(apiKey string, organizationId string, startDate string, endDate string) ([]string, error) {
+	query := url.Values{}
+	query.Set("startDate", startDate)
+	query.Set("endDate", endDate)
+
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/audit-logs?"+query.Encode(), nil)
+	httpResp, err := makeAuthorizedRequest(request, apiKey)
+	if err != nil {
+		return nil, fmt.Errorf("Request Error: %s", err)
+	}
+	defer httpResp.Body.Close()
+
+	b, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Request Error: %s", err)
+	}
+
+	if httpResp.StatusCode >= 300 {
+		errorResponse := new(ErrorResponse)
+		if err := json.Unmarshal(b, &errorResponse); err != nil || errorResponse.Message == "" {
+			return nil, fmt.Errorf("API Error: %s", httpResp.Status)
+		}
+		return nil, fmt.Errorf("API Error: %s", errorResponse.Message)
+	}
+
+	var events []string = []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(b)+1)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if !json.Valid(line) {
+			return nil, fmt.Errorf("API Error: invalid audit log event: %s", line)
+		}
+		events = append(events, string(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	return events, nil
+}
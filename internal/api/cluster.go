@@ -18,6 +18,7 @@ const (
 	ClusterStatusCreated      = "CREATED"
 	ClusterStatusCreateFailed = "CREATE_FAILED"
 	ClusterStatusUpdating     = "UPDATING"
+	ClusterStatusUpdateFailed = "UPDATE_FAILED"
 )
 
 const (
@@ -56,6 +57,7 @@ type NodePoolResponse struct {
 }
 
 type NodePoolRequest struct {
+	Id               string `json:"id,omitempty"`
 	IsDefault        bool   `json:"isDefault"`
 	MaxNodeCount     int    `json:"maxNodeCount"`
 	Name             string `json:"name"`
@@ -78,6 +80,7 @@ type ClusterResponse struct {
 	ServicePeeringRange string                  `json:"servicePeeringRange"`
 	ServiceSubnetRange  string                  `json:"serviceSubnetRange"`
 	Status              string                  `json:"status"`
+	StatusReason        string                  `json:"statusReason"`
 	Tags                []ClusterK8sTags        `json:"tags"`
 	TenantId            string                  `json:"tenantId"`
 	Type                string                  `json:"type"`
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Statuses shared by VPC peerings, private endpoints and routes. A connection waits in
+// PENDING_ACCEPTANCE until it's accepted in the customer's cloud account.
+const (
+	ClusterConnectionStatusProvisioning      = "PROVISIONING"
+	ClusterConnectionStatusPendingAcceptance = "PENDING_ACCEPTANCE"
+	ClusterConnectionStatusActive            = "ACTIVE"
+	ClusterConnectionStatusFailed            = "FAILED"
+	ClusterConnectionStatusDeleting          = "DELETING"
+)
+
+const (
+	PrivateEndpointTypeAwsPrivateLink           = "AWS_PRIVATE_LINK"
+	PrivateEndpointTypeGcpPrivateServiceConnect = "GCP_PRIVATE_SERVICE_CONNECT"
+)
+
+type VpcPeeringResponse struct {
+	AstroNetworkId string `json:"astroNetworkId"`
+	AstroProjectId string `json:"astroProjectId"`
+	ClusterId      string `json:"clusterId"`
+	CreatedAt      string `json:"createdAt"`
+	Id             string `json:"id"`
+	PeerAccountId  string `json:"peerAccountId"`
+	PeerNetworkId  string `json:"peerNetworkId"`
+	PeerProjectId  string `json:"peerProjectId"`
+	PeerRegion     string `json:"peerRegion"`
+	PeeringId      string `json:"peeringId"`
+	Status         string `json:"status"`
+	StatusReason   string `json:"statusReason"`
+	UpdatedAt      string `json:"updatedAt"`
+}
+
+type VpcPeeringCreateRequest struct {
+	PeerAccountId string `json:"peerAccountId,omitempty"`
+	PeerNetworkId string `json:"peerNetworkId"`
+	PeerProjectId string `json:"peerProjectId,omitempty"`
+	PeerRegion    string `json:"peerRegion,omitempty"`
+}
+
+type PrivateEndpointResponse struct {
+	ClusterId    string   `json:"clusterId"`
+	CreatedAt    string   `json:"createdAt"`
+	DnsNames     []string `json:"dnsNames"`
+	EndpointId   string   `json:"endpointId"`
+	Id           string   `json:"id"`
+	IpAddress    string   `json:"ipAddress"`
+	ServiceName  string   `json:"serviceName"`
+	Status       string   `json:"status"`
+	StatusReason string   `json:"statusReason"`
+	Type         string   `json:"type"`
+	UpdatedAt    string   `json:"updatedAt"`
+}
+
+type PrivateEndpointCreateRequest struct {
+	ServiceName string `json:"serviceName"`
+	Type        string `json:"type"`
+}
+
+type ClusterRouteResponse struct {
+	ClusterId       string   `json:"clusterId"`
+	CreatedAt       string   `json:"createdAt"`
+	DestinationCidr string   `json:"destinationCidr"`
+	Id              string   `json:"id"`
+	RouteTableIds   []string `json:"routeTableIds"`
+	Status          string   `json:"status"`
+	StatusReason    string   `json:"statusReason"`
+	TargetId        string   `json:"targetId"`
+	UpdatedAt       string   `json:"updatedAt"`
+}
+
+type ClusterRouteCreateRequest struct {
+	DestinationCidr string `json:"destinationCidr"`
+	TargetId        string `json:"targetId"`
+}
+
+func createClusterConnection(apiKey string, organizationId string, clusterId string, kind string, createRequest any, decoded any) error {
+	b, err := json.Marshal(createRequest)
+	if err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/clusters/"+clusterId+"/"+kind, bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	err = getObjectFromApi(apiKey, request, decoded)
+	if err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	return nil
+}
+
+func getClusterConnection(apiKey string, organizationId string, clusterId string, kind string, id string, decoded any) error {
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/clusters/"+clusterId+"/"+kind+"/"+id, nil)
+	err := getObjectFromApi(apiKey, request, decoded)
+	if err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	return nil
+}
+
+func deleteClusterConnection(apiKey string, organizationId string, clusterId string, kind string, id string) error {
+	request, _ := http.NewRequest("DELETE", urlBase+organizationId+"/clusters/"+clusterId+"/"+kind+"/"+id, nil)
+	_, httpErr := makeAuthorizedRequest(request, apiKey)
+
+	if httpErr != nil {
+		return fmt.Errorf("Delete Error: %s", httpErr)
+	}
+	return nil
+}
+
+func GetVpcPeering(apiKey string, organizationId string, clusterId string, vpcPeeringId string) (*VpcPeeringResponse, error) {
+	decoded := new(VpcPeeringResponse)
+	if err := getClusterConnection(apiKey, organizationId, clusterId, "vpc-peerings", vpcPeeringId, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func CreateVpcPeering(apiKey string, organizationId string, clusterId string, createRequest *VpcPeeringCreateRequest) (*VpcPeeringResponse, error) {
+	decoded := new(VpcPeeringResponse)
+	if err := createClusterConnection(apiKey, organizationId, clusterId, "vpc-peerings", createRequest, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func DeleteVpcPeering(apiKey string, organizationId string, clusterId string, vpcPeeringId string) error {
+	return deleteClusterConnection(apiKey, organizationId, clusterId, "vpc-peerings", vpcPeeringId)
+}
+
+func GetPrivateEndpoint(apiKey string, organizationId string, clusterId string, privateEndpointId string) (*PrivateEndpointResponse, error) {
+	decoded := new(PrivateEndpointResponse)
+	if err := getClusterConnection(apiKey, organizationId, clusterId, "private-endpoints", privateEndpointId, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func CreatePrivateEndpoint(apiKey string, organizationId string, clusterId string, createRequest *PrivateEndpointCreateRequest) (*PrivateEndpointResponse, error) {
+	decoded := new(PrivateEndpointResponse)
+	if err := createClusterConnection(apiKey, organizationId, clusterId, "private-endpoints", createRequest, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func DeletePrivateEndpoint(apiKey string, organizationId string, clusterId string, privateEndpointId string) error {
+	return deleteClusterConnection(apiKey, organizationId, clusterId, "private-endpoints", privateEndpointId)
+}
+
+func GetClusterRoute(apiKey string, organizationId string, clusterId string, routeId string) (*ClusterRouteResponse, error) {
+	decoded := new(ClusterRouteResponse)
+	if err := getClusterConnection(apiKey, organizationId, clusterId, "routes", routeId, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func CreateClusterRoute(apiKey string, organizationId string, clusterId string, createRequest *ClusterRouteCreateRequest) (*ClusterRouteResponse, error) {
+	decoded := new(ClusterRouteResponse)
+	if err := createClusterConnection(apiKey, organizationId, clusterId, "routes", createRequest, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func DeleteClusterRoute(apiKey string, organizationId string, clusterId string, routeId string) error {
+	return deleteClusterConnection(apiKey, organizationId, clusterId, "routes", routeId)
+}
@@ -17,9 +17,10 @@ const (
 )
 
 const (
-	SchedulerSizeSmall  = "SMALL"
-	SchedulerSizeMedium = "MEDIUM"
-	SchedulerSizeLarge  = "LARGE"
+	SchedulerSizeSmall      = "SMALL"
+	SchedulerSizeMedium     = "MEDIUM"
+	SchedulerSizeLarge      = "LARGE"
+	SchedulerSizeExtraLarge = "EXTRA_LARGE"
 )
 
 const (
@@ -50,7 +51,8 @@ type EnvironmentVariableResponse struct {
 type EnvironmentVariableRequest struct {
 	IsSecret bool   `json:"isSecret"`
 	Key      string `json:"key"`
-	Value    string `json:"value"`
+	// Value is omitted for secrets whose value should be left unchanged.
+	Value string `json:"value,omitempty"`
 }
 
 type DeploymentResponse struct {
@@ -74,6 +76,7 @@ type DeploymentResponse struct {
 	ImageVersion             string                        `json:"imageVersion"`
 	IsCicdEnforced           bool                          `json:"isCicdEnforced"`
 	IsDagDeployEnabled       bool                          `json:"isDagDeployEnabled"`
+	IsDevelopmentMode        bool                          `json:"isDevelopmentMode"`
 	IsHighAvailability       bool                          `json:"isHighAvailability"`
 	Name                     string                        `json:"name"`
 	Namespace                string                        `json:"namespace"`
@@ -83,6 +86,8 @@ type DeploymentResponse struct {
 	ResourceQuotaCpu         string                        `json:"resourceQuotaCpu"`
 	ResourceQuotaMemory      string                        `json:"resourceQuotaMemory"`
 	RuntimeVersion           string                        `json:"runtimeVersion"`
+	ScalingSpec              *DeploymentScalingSpec        `json:"scalingSpec"`
+	ScalingStatus            *DeploymentScalingStatus      `json:"scalingStatus"`
 	SchedulerAu              int                           `json:"schedulerAu"`
 	SchedulerCpu             string                        `json:"schedulerCpu"`
 	SchedulerMemory          string                        `json:"schedulerMemory"`
@@ -106,19 +111,54 @@ type DeploymentResponse struct {
 	WorkspaceName            string                        `json:"workspaceName"`
 }
 
+// WorkerQueue is sized either by AstroMachine (Standard and Dedicated Deployments) or by
+// NodePoolId (Hybrid Deployments), so the fields that don't apply are left out of requests.
 type WorkerQueue struct {
-	AstroMachine      string `json:"astroMachine"`
+	AstroMachine      string `json:"astroMachine,omitempty"`
 	Id                string `json:"id"`
 	IsDefault         bool   `json:"isDefault"`
 	MaxWorkerCount    int    `json:"maxWorkerCount"`
 	MinWorkerCount    int    `json:"minWorkerCount"`
 	Name              string `json:"name"`
-	NodePoolId        string `json:"nodePoolId"`
-	PodCpu            string `json:"podCpu"`
-	PodMemory         string `json:"podMemory"`
+	NodePoolId        string `json:"nodePoolId,omitempty"`
+	PodCpu            string `json:"podCpu,omitempty"`
+	PodMemory         string `json:"podMemory,omitempty"`
 	WorkerConcurrency int    `json:"workerConcurrency"`
 }
 
+type DeploymentScalingSpec struct {
+	HibernationSpec *DeploymentHibernationSpec `json:"hibernationSpec,omitempty"`
+}
+
+type DeploymentHibernationSpec struct {
+	Override  *DeploymentHibernationOverride  `json:"override,omitempty"`
+	Schedules []DeploymentHibernationSchedule `json:"schedules"`
+}
+
+type DeploymentHibernationSchedule struct {
+	Description     string `json:"description,omitempty"`
+	HibernateAtCron string `json:"hibernateAtCron"`
+	IsEnabled       bool   `json:"isEnabled"`
+	WakeAtCron      string `json:"wakeAtCron"`
+}
+
+type DeploymentHibernationOverride struct {
+	IsActive      bool   `json:"isActive,omitempty"`
+	IsHibernating bool   `json:"isHibernating"`
+	OverrideUntil string `json:"overrideUntil,omitempty"`
+}
+
+type DeploymentScalingStatus struct {
+	HibernationStatus *DeploymentHibernationStatus `json:"hibernationStatus"`
+}
+
+type DeploymentHibernationStatus struct {
+	IsHibernating bool   `json:"isHibernating"`
+	NextEventAt   string `json:"nextEventAt"`
+	NextEventType string `json:"nextEventType"`
+	Reason        string `json:"reason"`
+}
+
 type SchedulerRequest struct {
 	Au       int `json:"au"`
 	Replicas int `json:"replicas"`
@@ -135,11 +175,13 @@ type DeploymentCreateRequest struct {
 	Executor             string                       `json:"executor"`
 	IsCicdEnforced       bool                         `json:"isCicdEnforced"`
 	IsDagDeployEnabled   bool                         `json:"isDagDeployEnabled"`
+	IsDevelopmentMode    bool                         `json:"isDevelopmentMode"`
 	IsHighAvailability   bool                         `json:"isHighAvailability"`
 	Name                 string                       `json:"name"`
 	Region               string                       `json:"region,omitempty"`
 	ResourceQuotaCpu     string                       `json:"resourceQuotaCpu"`
 	ResourceQuotaMemory  string                       `json:"resourceQuotaMemory"`
+	ScalingSpec          *DeploymentScalingSpec       `json:"scalingSpec,omitempty"`
 	Scheduler            *SchedulerRequest            `json:"scheduler"`
 	SchedulerSize        string                       `json:"schedulerSize"`
 	TaskPodNodePoolId    string                       `json:"taskPodNodePoolId"`
@@ -149,6 +191,7 @@ type DeploymentCreateRequest struct {
 }
 
 type DeploymentUpdateRequest struct {
+	AstroRuntimeVersion  string                       `json:"astroRuntimeVersion,omitempty"`
 	ContactEmails        []string                     `json:"contactEmails"`
 	DefaultTaskPodCpu    string                       `json:"defaultTaskPodCpu"`
 	DefaultTaskPodMemory string                       `json:"defaultTaskPodMemory"`
@@ -157,10 +200,12 @@ type DeploymentUpdateRequest struct {
 	Executor             string                       `json:"executor"`
 	IsCicdEnforced       bool                         `json:"isCicdEnforced"`
 	IsDagDeployEnabled   bool                         `json:"isDagDeployEnabled"`
+	IsDevelopmentMode    bool                         `json:"isDevelopmentMode"`
 	IsHighAvailability   bool                         `json:"isHighAvailability"`
 	Name                 string                       `json:"name"`
 	ResourceQuotaCpu     string                       `json:"resourceQuotaCpu"`
 	ResourceQuotaMemory  string                       `json:"resourceQuotaMemory"`
+	ScalingSpec          *DeploymentScalingSpec       `json:"scalingSpec,omitempty"`
 	Scheduler            *SchedulerRequest            `json:"scheduler"`
 	SchedulerSize        string                       `json:"schedulerSize"`
 	TaskPodNodePoolId    string                       `json:"taskPodNodePoolId"`
@@ -229,3 +274,33 @@ func UpdateDeployment(apiKey string, organizationId string, deploymentId string,
 	}
 	return decoded, nil
 }
+
+func UpdateDeploymentHibernationOverride(apiKey string, organizationId string, deploymentId string, overrideRequest *DeploymentHibernationOverride) (*DeploymentHibernationOverride, error) {
+	if deploymentId == "" {
+		return nil, fmt.Errorf("No Deployment ID Given.")
+	}
+	b, err := json.Marshal(overrideRequest)
+	if err != nil {
+		return nil, fmt.Errorf("Error: %s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/deployments/"+deploymentId+"/hibernation-override", bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+
+	decoded := new(DeploymentHibernationOverride)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func DeleteDeploymentHibernationOverride(apiKey string, organizationId string, deploymentId string) error {
+	request, _ := http.NewRequest("DELETE", urlBase+organizationId+"/deployments/"+deploymentId+"/hibernation-override", nil)
+	_, httpErr := makeAuthorizedRequest(request, apiKey)
+
+	if httpErr != nil {
+		return fmt.Errorf("Delete Error: %s", httpErr)
+	}
+	return nil
+}
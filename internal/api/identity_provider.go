@@ -0,0 +1,111 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	IdentityProviderTypeOidc = "OIDC"
+	IdentityProviderTypeSaml = "SAML"
+)
+
+const (
+	IdentityProviderStatusActive   = "ACTIVE"
+	IdentityProviderStatusInactive = "INACTIVE"
+)
+
+type SamlConnection struct {
+	AuthRequestUrl string `json:"authRequestUrl"`
+	SigningCert    string `json:"signingCert"`
+}
+
+type OidcConnection struct {
+	ClientId     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+	DiscoveryUrl string `json:"discoveryUrl"`
+}
+
+type IdentityProviderResponse struct {
+	AcsUrl            string                  `json:"acsUrl"`
+	CreatedAt         string                  `json:"createdAt"`
+	EntityId          string                  `json:"entityId"`
+	Id                string                  `json:"id"`
+	ManagedDomains    []ManagedDomainResponse `json:"managedDomains"`
+	OidcConnection    *OidcConnection         `json:"oidcConnection"`
+	SamlConnection    *SamlConnection         `json:"samlConnection"`
+	Status            string                  `json:"status"`
+	Type              string                  `json:"type"`
+	UpdatedAt         string                  `json:"updatedAt"`
+	VerificationToken string                  `json:"verificationToken"`
+}
+
+type IdentityProviderCreateRequest struct {
+	ManagedDomainIds []string        `json:"managedDomainIds"`
+	OidcConnection   *OidcConnection `json:"oidcConnection,omitempty"`
+	SamlConnection   *SamlConnection `json:"samlConnection,omitempty"`
+	Type             string          `json:"type"`
+}
+
+type IdentityProviderUpdateRequest struct {
+	ManagedDomainIds []string        `json:"managedDomainIds"`
+	OidcConnection   *OidcConnection `json:"oidcConnection,omitempty"`
+	SamlConnection   *SamlConnection `json:"samlConnection,omitempty"`
+}
+
+func GetIdentityProvider(apiKey string, organizationId string, identityProviderId string) (*IdentityProviderResponse, error) {
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/identity-providers/"+identityProviderId, nil)
+	decoded := new(IdentityProviderResponse)
+	err := getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func CreateIdentityProvider(apiKey string, organizationId string, createRequest *IdentityProviderCreateRequest) (*IdentityProviderResponse, error) {
+	b, err := json.Marshal(createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/identity-providers", bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(IdentityProviderResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func UpdateIdentityProvider(apiKey string, organizationId string, identityProviderId string, updateRequest *IdentityProviderUpdateRequest) (*IdentityProviderResponse, error) {
+	if identityProviderId == "" {
+		return nil, fmt.Errorf("No Identity Provider ID Given.")
+	}
+	b, err := json.Marshal(updateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/identity-providers/"+identityProviderId, bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(IdentityProviderResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func DeleteIdentityProvider(apiKey string, organizationId string, identityProviderId string) error {
+	request, _ := http.NewRequest("DELETE", urlBase+organizationId+"/identity-providers/"+identityProviderId, nil)
+	_, httpErr := makeAuthorizedRequest(request, apiKey)
+
+	if httpErr != nil {
+		return fmt.Errorf("Delete Error: %s", httpErr)
+	}
+	return nil
+}
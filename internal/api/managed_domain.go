@@ -0,0 +1,95 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ManagedDomainStatusPending  = "PENDING"
+	ManagedDomainStatusVerified = "VERIFIED"
+)
+
+const (
+	EnforcedLoginPassword = "PASSWORD"
+	EnforcedLoginGithub   = "GITHUB"
+	EnforcedLoginGoogle   = "GOOGLE"
+	EnforcedLoginSso      = "SSO"
+)
+
+type ManagedDomainResponse struct {
+	CreatedAt         string   `json:"createdAt"`
+	EnforcedLogins    []string `json:"enforcedLogins"`
+	Id                string   `json:"id"`
+	Name              string   `json:"name"`
+	OrganizationId    string   `json:"organizationId"`
+	Status            string   `json:"status"`
+	UpdatedAt         string   `json:"updatedAt"`
+	VerificationToken string   `json:"verificationToken"`
+}
+
+type ManagedDomainCreateRequest struct {
+	EnforcedLogins []string `json:"enforcedLogins"`
+	Name           string   `json:"name"`
+}
+
+type ManagedDomainUpdateRequest struct {
+	EnforcedLogins []string `json:"enforcedLogins"`
+}
+
+func GetManagedDomain(apiKey string, organizationId string, domainId string) (*ManagedDomainResponse, error) {
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/domains/"+domainId, nil)
+	decoded := new(ManagedDomainResponse)
+	err := getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func CreateManagedDomain(apiKey string, organizationId string, createRequest *ManagedDomainCreateRequest) (*ManagedDomainResponse, error) {
+	b, err := json.Marshal(createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/domains", bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(ManagedDomainResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func UpdateManagedDomain(apiKey string, organizationId string, domainId string, updateRequest *ManagedDomainUpdateRequest) (*ManagedDomainResponse, error) {
+	if domainId == "" {
+		return nil, fmt.Errorf("No Managed Domain ID Given.")
+	}
+	b, err := json.Marshal(updateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/domains/"+domainId, bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(ManagedDomainResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func DeleteManagedDomain(apiKey string, organizationId string, domainId string) error {
+	request, _ := http.NewRequest("DELETE", urlBase+organizationId+"/domains/"+domainId, nil)
+	_, httpErr := makeAuthorizedRequest(request, apiKey)
+
+	if httpErr != nil {
+		return fmt.Errorf("Delete Error: %s", httpErr)
+	}
+	return nil
+}
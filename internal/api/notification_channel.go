@@ -0,0 +1,123 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	NotificationChannelTypeDagTrigger = "DAG_TRIGGER"
+	NotificationChannelTypeEmail      = "EMAIL"
+	NotificationChannelTypeOpsgenie   = "OPSGENIE"
+	NotificationChannelTypePagerDuty  = "PAGERDUTY"
+	NotificationChannelTypeSlack      = "SLACK"
+)
+
+const (
+	NotificationChannelEntityTypeDeployment   = "DEPLOYMENT"
+	NotificationChannelEntityTypeOrganization = "ORGANIZATION"
+	NotificationChannelEntityTypeWorkspace    = "WORKSPACE"
+)
+
+// NotificationChannelDefinition holds the settings of every channel type, only the ones of the
+// channel's type are set. The API doesn't return the secret fields.
+type NotificationChannelDefinition struct {
+	ApiKey             string   `json:"apiKey,omitempty"`
+	DagId              string   `json:"dagId,omitempty"`
+	DeploymentApiToken string   `json:"deploymentApiToken,omitempty"`
+	DeploymentId       string   `json:"deploymentId,omitempty"`
+	IntegrationKey     string   `json:"integrationKey,omitempty"`
+	Recipients         []string `json:"recipients,omitempty"`
+	WebhookUrl         string   `json:"webhookUrl,omitempty"`
+}
+
+type NotificationChannelResponse struct {
+	CreatedAt      string                        `json:"createdAt"`
+	CreatedBy      BasicSubjectProfileResponse   `json:"createdBy"`
+	Definition     NotificationChannelDefinition `json:"definition"`
+	DeploymentId   string                        `json:"deploymentId"`
+	EntityId       string                        `json:"entityId"`
+	EntityName     string                        `json:"entityName"`
+	EntityType     string                        `json:"entityType"`
+	Id             string                        `json:"id"`
+	IsShared       bool                          `json:"isShared"`
+	Name           string                        `json:"name"`
+	OrganizationId string                        `json:"organizationId"`
+	Type           string                        `json:"type"`
+	UpdatedAt      string                        `json:"updatedAt"`
+	UpdatedBy      BasicSubjectProfileResponse   `json:"updatedBy"`
+	WorkspaceId    string                        `json:"workspaceId"`
+}
+
+type NotificationChannelCreateRequest struct {
+	Definition NotificationChannelDefinition `json:"definition"`
+	EntityId   string                        `json:"entityId"`
+	EntityType string                        `json:"entityType"`
+	IsShared   bool                          `json:"isShared"`
+	Name       string                        `json:"name"`
+	Type       string                        `json:"type"`
+}
+
+type NotificationChannelUpdateRequest struct {
+	Definition NotificationChannelDefinition `json:"definition"`
+	IsShared   bool                          `json:"isShared"`
+	Name       string                        `json:"name"`
+	Type       string                        `json:"type"`
+}
+
+func GetNotificationChannel(apiKey string, organizationId string, notificationChannelId string) (*NotificationChannelResponse, error) {
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/notification-channels/"+notificationChannelId, nil)
+	decoded := new(NotificationChannelResponse)
+	err := getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func CreateNotificationChannel(apiKey string, organizationId string, createRequest *NotificationChannelCreateRequest) (*NotificationChannelResponse, error) {
+	b, err := json.Marshal(createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/notification-channels", bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(NotificationChannelResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func UpdateNotificationChannel(apiKey string, organizationId string, notificationChannelId string, updateRequest *NotificationChannelUpdateRequest) (*NotificationChannelResponse, error) {
+	if notificationChannelId == "" {
+		return nil, fmt.Errorf("No Notification Channel ID Given.")
+	}
+	b, err := json.Marshal(updateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/notification-channels/"+notificationChannelId, bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(NotificationChannelResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func DeleteNotificationChannel(apiKey string, organizationId string, notificationChannelId string) error {
+	request, _ := http.NewRequest("DELETE", urlBase+organizationId+"/notification-channels/"+notificationChannelId, nil)
+	_, httpErr := makeAuthorizedRequest(request, apiKey)
+
+	if httpErr != nil {
+		return fmt.Errorf("Delete Error: %s", httpErr)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type ResourceRange struct {
+	Ceiling string `json:"ceiling"`
+	Default string `json:"default"`
+	Floor   string `json:"floor"`
+}
+
+type Range struct {
+	Ceiling int `json:"ceiling"`
+	Default int `json:"default"`
+	Floor   int `json:"floor"`
+}
+
+type ResourceOption struct {
+	Cpu    ResourceRange `json:"cpu"`
+	Memory ResourceRange `json:"memory"`
+}
+
+type ResourceQuotaOptions struct {
+	DefaultPodSize ResourceOption `json:"defaultPodSize"`
+	ResourceQuota  ResourceOption `json:"resourceQuota"`
+}
+
+type MachineSpec struct {
+	Concurrency *Range `json:"concurrency,omitempty"`
+	Cpu         string `json:"cpu"`
+	Memory      string `json:"memory"`
+}
+
+type MachineOption struct {
+	Name string      `json:"name"`
+	Spec MachineSpec `json:"spec"`
+}
+
+type RuntimeReleaseOption struct {
+	AirflowVersion string `json:"airflowVersion"`
+	Channel        string `json:"channel"`
+	ReleaseDate    string `json:"releaseDate"`
+	Version        string `json:"version"`
+}
+
+type WorkerQueueOptions struct {
+	MaxWorkers        Range `json:"maxWorkers"`
+	MinWorkers        Range `json:"minWorkers"`
+	WorkerConcurrency Range `json:"workerConcurrency"`
+}
+
+type DeploymentOptionsResponse struct {
+	Executors         []string               `json:"executors"`
+	ResourceQuotas    ResourceQuotaOptions   `json:"resourceQuotas"`
+	RuntimeReleases   []RuntimeReleaseOption `json:"runtimeReleases"`
+	SchedulerMachines []MachineOption        `json:"schedulerMachines"`
+	WorkerMachines    []MachineOption        `json:"workerMachines"`
+	WorkerQueues      WorkerQueueOptions     `json:"workerQueues"`
+}
+
+// DeploymentOptionsRequest narrows the options down. Empty fields aren't sent.
+type DeploymentOptionsRequest struct {
+	CloudProvider  string
+	DeploymentType string
+	Executor       string
+}
+
+type ProviderInstanceType struct {
+	Cpu    int    `json:"cpu"`
+	Memory string `json:"memory"`
+	Name   string `json:"name"`
+}
+
+type ProviderRegion struct {
+	BannedInstances []string `json:"bannedInstances"`
+	Limited         bool     `json:"limited"`
+	Name            string   `json:"name"`
+}
+
+type ClusterOptionsResponse struct {
+	DatabaseInstances          []ProviderInstanceType `json:"databaseInstances"`
+	DefaultDatabaseInstance    ProviderInstanceType   `json:"defaultDatabaseInstance"`
+	DefaultNodeInstance        ProviderInstanceType   `json:"defaultNodeInstance"`
+	DefaultPodSubnetRange      string                 `json:"defaultPodSubnetRange"`
+	DefaultRegion              ProviderRegion         `json:"defaultRegion"`
+	DefaultServicePeeringRange string                 `json:"defaultServicePeeringRange"`
+	DefaultServiceSubnetRange  string                 `json:"defaultServiceSubnetRange"`
+	DefaultVpcSubnetRange      string                 `json:"defaultVpcSubnetRange"`
+	NodeCountDefault           int                    `json:"nodeCountDefault"`
+	NodeCountMax               int                    `json:"nodeCountMax"`
+	NodeCountMin               int                    `json:"nodeCountMin"`
+	NodeInstances              []ProviderInstanceType `json:"nodeInstances"`
+	Provider                   string                 `json:"provider"`
+	Regions                    []ProviderRegion       `json:"regions"`
+}
+
+func GetDeploymentOptions(apiKey string, organizationId string, optionsRequest *DeploymentOptionsRequest) (*DeploymentOptionsResponse, error) {
+	query := url.Values{}
+	if optionsRequest.CloudProvider != "" {
+		query.Set("cloudProvider", optionsRequest.CloudProvider)
+	}
+	if optionsRequest.DeploymentType != "" {
+		query.Set("deploymentType", optionsRequest.DeploymentType)
+	}
+	if optionsRequest.Executor != "" {
+		query.Set("executor", optionsRequest.Executor)
+	}
+
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/deployment-options?"+query.Encode(), nil)
+	decoded := new(DeploymentOptionsResponse)
+	err := getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+// GetClusterOptions returns the options of each cloud provider, or only of cloudProvider if given.
+func GetClusterOptions(apiKey string, organizationId string, clusterType string, cloudProvider string) ([]ClusterOptionsResponse, error) {
+	query := url.Values{}
+	query.Set("type", clusterType)
+	if cloudProvider != "" {
+		query.Set("provider", cloudProvider)
+	}
+
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/cluster-options?"+query.Encode(), nil)
+	decoded := []ClusterOptionsResponse{}
+	err := getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
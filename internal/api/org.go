@@ -1,6 +1,8 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -13,20 +15,36 @@ type OrgListResponse struct {
 }
 
 type OrgResponse struct {
-	BillingEmail   string                      `json:"billingEmail"`
-	CreatedAt      string                      `json:"createdAt"`
-	CreatedBy      BasicSubjectProfileResponse `json:"createdBy"`
-	Id             string                      `json:"id"`
-	IsScimEnabled  bool                        `json:"isScimEnabled"`
-	ManagedDomains []ManagedDomainResponse     `json:"managedDomains"`
-	Name           string                      `json:"name"`
-	PaymentMethod  string                      `json:"paymentMethod"`
-	Product        string                      `json:"product"`
-	Status         string                      `json:"status"`
-	SupportPlan    string                      `json:"supportPlan"`
-	TrialExpiresAt string                      `json:"trialExpiresAt"`
-	UpdatedAt      string                      `json:"updatedAt"`
-	UpdatedBy      BasicSubjectProfileResponse `json:"updatedBy"`
+	BillingEmail                      string                       `json:"billingEmail"`
+	CreatedAt                         string                       `json:"createdAt"`
+	CreatedBy                         BasicSubjectProfileResponse  `json:"createdBy"`
+	DefaultDeploymentSettings         OrgDefaultDeploymentSettings `json:"defaultDeploymentSettings"`
+	EnvironmentSecretsFetchingEnabled bool                         `json:"environmentSecretsFetchingEnabled"`
+	Id                                string                       `json:"id"`
+	IsScimEnabled                     bool                         `json:"isScimEnabled"`
+	ManagedDomains                    []ManagedDomainResponse      `json:"managedDomains"`
+	Name                              string                       `json:"name"`
+	PaymentMethod                     string                       `json:"paymentMethod"`
+	Product                           string                       `json:"product"`
+	Status                            string                       `json:"status"`
+	SupportPlan                       string                       `json:"supportPlan"`
+	TrialExpiresAt                    string                       `json:"trialExpiresAt"`
+	UpdatedAt                         string                       `json:"updatedAt"`
+	UpdatedBy                         BasicSubjectProfileResponse  `json:"updatedBy"`
+}
+
+type OrgDefaultDeploymentSettings struct {
+	IsCicdEnforced     bool `json:"isCicdEnforced"`
+	IsDagDeployEnabled bool `json:"isDagDeployEnabled"`
+	IsHighAvailability bool `json:"isHighAvailability"`
+}
+
+type OrgUpdateRequest struct {
+	BillingEmail                      string                       `json:"billingEmail"`
+	DefaultDeploymentSettings         OrgDefaultDeploymentSettings `json:"defaultDeploymentSettings"`
+	EnvironmentSecretsFetchingEnabled bool                         `json:"environmentSecretsFetchingEnabled"`
+	IsScimEnabled                     bool                         `json:"isScimEnabled"`
+	Name                              string                       `json:"name"`
 }
 
 type BasicSubjectProfileResponse struct {
@@ -38,15 +56,6 @@ type BasicSubjectProfileResponse struct {
 	Username     string `json:"username"`
 }
 
-type ManagedDomainResponse struct {
-	CreatedAt      string   `json:"createdAt"`
-	EnforcedLogins []string `json:"enforcedLogins"`
-	Id             string   `json:"id"`
-	Name           string   `json:"name"`
-	Status         string   `json:"status"`
-	UpdatedAt      string   `json:"updatedAt"`
-}
-
 func GetOrgs(apiKey string) (*OrgListResponse, error) {
 	request, _ := http.NewRequest("GET", urlBase, nil)
 	decoded := new(OrgListResponse)
@@ -68,3 +77,23 @@ func GetOrg(apiKey string, orgId string) (*OrgResponse, error) {
 
 	return decoded, nil
 }
+
+func UpdateOrg(apiKey string, orgId string, updateRequest *OrgUpdateRequest) (*OrgResponse, error) {
+	if orgId == "" {
+		return nil, fmt.Errorf("No Organization ID Given.")
+	}
+	b, err := json.Marshal(updateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("Error: %s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+orgId, bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(OrgResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	return decoded, nil
+}
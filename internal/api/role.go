@@ -0,0 +1,118 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	RoleScopeTypeDeployment = "DEPLOYMENT"
+)
+
+type Permission struct {
+	Action      string `json:"action"`
+	Description string `json:"description"`
+}
+
+type PermissionGroup struct {
+	Description string       `json:"description"`
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+	Scope       string       `json:"scope"`
+}
+
+type RoleResponse struct {
+	CreatedAt              string                      `json:"createdAt"`
+	CreatedBy              BasicSubjectProfileResponse `json:"createdBy"`
+	Description            string                      `json:"description"`
+	Id                     string                      `json:"id"`
+	Name                   string                      `json:"name"`
+	Permissions            []string                    `json:"permissions"`
+	RestrictedWorkspaceIds []string                    `json:"restrictedWorkspaceIds"`
+	ScopeType              string                      `json:"scopeType"`
+	UpdatedAt              string                      `json:"updatedAt"`
+	UpdatedBy              BasicSubjectProfileResponse `json:"updatedBy"`
+}
+
+type RoleCreateRequest struct {
+	Description            string   `json:"description"`
+	Name                   string   `json:"name"`
+	Permissions            []string `json:"permissions"`
+	RestrictedWorkspaceIds []string `json:"restrictedWorkspaceIds"`
+	ScopeType              string   `json:"scopeType"`
+}
+
+type RoleUpdateRequest struct {
+	Description            string   `json:"description"`
+	Name                   string   `json:"name"`
+	Permissions            []string `json:"permissions"`
+	RestrictedWorkspaceIds []string `json:"restrictedWorkspaceIds"`
+}
+
+func GetPermissionGroups(apiKey string, organizationId string, scopeType string) ([]PermissionGroup, error) {
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/permission-groups?scopeType="+url.QueryEscape(scopeType), nil)
+	decoded := []PermissionGroup{}
+	err := getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func GetRole(apiKey string, organizationId string, roleId string) (*RoleResponse, error) {
+	request, _ := http.NewRequest("GET", urlBase+organizationId+"/roles/"+roleId, nil)
+	decoded := new(RoleResponse)
+	err := getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func CreateRole(apiKey string, organizationId string, createRequest *RoleCreateRequest) (*RoleResponse, error) {
+	b, err := json.Marshal(createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/roles", bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(RoleResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func UpdateRole(apiKey string, organizationId string, roleId string, updateRequest *RoleUpdateRequest) (*RoleResponse, error) {
+	if roleId == "" {
+		return nil, fmt.Errorf("No Role ID Given.")
+	}
+	b, err := json.Marshal(updateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+
+	request, _ := http.NewRequest("POST", urlBase+organizationId+"/roles/"+roleId, bytes.NewBuffer(b))
+	request.Header.Set("Content-Type", "application/json")
+	decoded := new(RoleResponse)
+	err = getObjectFromApi(apiKey, request, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s", err)
+	}
+	return decoded, nil
+}
+
+func DeleteRole(apiKey string, organizationId string, roleId string) error {
+	request, _ := http.NewRequest("DELETE", urlBase+organizationId+"/roles/"+roleId, nil)
+	_, httpErr := makeAuthorizedRequest(request, apiKey)
+
+	if httpErr != nil {
+		return fmt.Errorf("Delete Error: %s", httpErr)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	RuntimeReleaseChannelStable     = "stable"
+	RuntimeReleaseChannelDeprecated = "deprecated"
+)
+
+// Astro Runtime releases are published on the public updates feed rather than the platform API,
+// so the request isn't authorized.
+const runtimeReleasesUrl string = "https://updates.astronomer.io/astronomer-runtime"
+
+type RuntimeReleaseMetadata struct {
+	AirflowVersion  string `json:"airflowVersion"`
+	Channel         string `json:"channel"`
+	DeprecationDate string `json:"deprecationDate"`
+	EndOfSupport    string `json:"endOfSupport"`
+	ReleaseDate     string `json:"releaseDate"`
+}
+
+type RuntimeRelease struct {
+	Metadata RuntimeReleaseMetadata `json:"metadata"`
+}
+
+type RuntimeReleasesResponse struct {
+	RuntimeVersions map[string]RuntimeRelease `json:"runtimeVersions"`
+}
+
+func GetRuntimeReleases() (map[string]RuntimeRelease, error) {
+	request, _ := http.NewRequest("GET", runtimeReleasesUrl, nil)
+	client := &http.Client{}
+	httpResp, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("Request Error: %s", err)
+	}
+
+	decoded := new(RuntimeReleasesResponse)
+	err = readErrorFirst(httpResp.Body, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("API Error: %s", err)
+	}
+	return decoded.RuntimeVersions, nil
+}
@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &AlertResource{}
+var _ resource.ResourceWithImportState = &AlertResource{}
+
+func NewAlertResource() resource.Resource {
+	return &AlertResource{}
+}
+
+type AlertResource struct {
+	token          string
+	organizationId string
+}
+
+type AlertResourceModel struct {
+	CreatedAt              types.String     `tfsdk:"created_at"`
+	DeploymentId           types.String     `tfsdk:"deployment_id"`
+	Id                     types.String     `tfsdk:"id"`
+	Name                   types.String     `tfsdk:"name"`
+	NotificationChannelIds []types.String   `tfsdk:"notification_channel_ids"`
+	Rules                  *AlertRulesModel `tfsdk:"rules"`
+	Severity               types.String     `tfsdk:"severity"`
+	Type                   types.String     `tfsdk:"type"`
+	UpdatedAt              types.String     `tfsdk:"updated_at"`
+}
+
+type AlertRulesModel struct {
+	DagDeadline           types.String             `tfsdk:"dag_deadline"`
+	DagDurationSeconds    types.Int64              `tfsdk:"dag_duration_seconds"`
+	DaysOfWeek            []types.String           `tfsdk:"days_of_week"`
+	LookBackPeriodSeconds types.Int64              `tfsdk:"look_back_period_seconds"`
+	PatternMatches        []AlertPatternMatchModel `tfsdk:"pattern_matches"`
+	TaskDurationSeconds   types.Int64              `tfsdk:"task_duration_seconds"`
+}
+
+type AlertPatternMatchModel struct {
+	EntityType   types.String   `tfsdk:"entity_type"`
+	OperatorType types.String   `tfsdk:"operator_type"`
+	Values       []types.String `tfsdk:"values"`
+}
+
+func (r *AlertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An alert on the DAGs or tasks of a Deployment, sent to one or more `astronomer_notification_channel`.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this alert was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Deployment the alert watches.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The alert's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The alert's name.",
+				Required:            true,
+			},
+			"notification_channel_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the notification channels the alert is sent to.",
+				Required:            true,
+			},
+			"rules": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"dag_deadline": schema.StringAttribute{
+						MarkdownDescription: "The time, in `HH:MM` UTC, by which the DAG must have succeeded. Required for `DAG_TIMELINESS` alerts.",
+						Optional:            true,
+					},
+					"dag_duration_seconds": schema.Int64Attribute{
+						MarkdownDescription: "How long a DAG run can take before the alert fires. Required for `DAG_DURATION` alerts.",
+						Optional:            true,
+					},
+					"days_of_week": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The days the deadline applies to, e.g. `MONDAY`. Required for `DAG_TIMELINESS` alerts.",
+						Optional:            true,
+					},
+					"look_back_period_seconds": schema.Int64Attribute{
+						MarkdownDescription: "How far before the deadline to look for a successful DAG run. Required for `DAG_TIMELINESS` alerts.",
+						Optional:            true,
+					},
+					"pattern_matches": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"entity_type": schema.StringAttribute{
+									MarkdownDescription: "What the pattern is matched against, `DAG_ID` or `TASK_ID`. `TASK_ID` only applies to task alerts.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(api.AlertPatternMatchEntityTypeDagId, api.AlertPatternMatchEntityTypeTaskId),
+									},
+								},
+								"operator_type": schema.StringAttribute{
+									MarkdownDescription: "How the values are matched. One of `IS`, `IS_NOT`, `INCLUDES` or `EXCLUDES`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(api.AlertPatternMatchOperatorExcludes, api.AlertPatternMatchOperatorIncludes, api.AlertPatternMatchOperatorIs, api.AlertPatternMatchOperatorIsNot),
+									},
+								},
+								"values": schema.ListAttribute{
+									ElementType:         types.StringType,
+									MarkdownDescription: "The values to match.",
+									Required:            true,
+								},
+							},
+						},
+						MarkdownDescription: "The DAGs and tasks the alert applies to. At least one `DAG_ID` pattern is required.",
+						Required:            true,
+					},
+					"task_duration_seconds": schema.Int64Attribute{
+						MarkdownDescription: "How long a task can run before the alert fires. Required for `TASK_DURATION` alerts.",
+						Optional:            true,
+					},
+				},
+				MarkdownDescription: "When the alert fires. Only the properties of the alert's `type` can be set.",
+				Required:            true,
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "The alert's severity. One of `INFO`, `WARNING` or `CRITICAL`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.AlertSeverityCritical, api.AlertSeverityInfo, api.AlertSeverityWarning),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The alert's type. One of `DAG_FAILURE`, `DAG_SUCCESS`, `DAG_DURATION`, `DAG_TIMELINESS`, `TASK_FAILURE` or `TASK_DURATION`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.AlertTypeDagDuration, api.AlertTypeDagFailure, api.AlertTypeDagSuccess, api.AlertTypeDagTimeliness, api.AlertTypeTaskDuration, api.AlertTypeTaskFailure),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the alert was updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AlertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+func validateAlertRules(data AlertResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.Rules == nil {
+		return diags
+	}
+	alertType := data.Type.ValueString()
+	rules := data.Rules
+	isTaskAlert := alertType == api.AlertTypeTaskDuration || alertType == api.AlertTypeTaskFailure
+
+	hasDagPattern := false
+	for _, value := range rules.PatternMatches {
+		switch value.EntityType.ValueString() {
+		case api.AlertPatternMatchEntityTypeDagId:
+			hasDagPattern = true
+		case api.AlertPatternMatchEntityTypeTaskId:
+			if !isTaskAlert {
+				diags.AddError("Validation Error", fmt.Sprintf("%s alerts can't match on TASK_ID", alertType))
+			}
+		}
+	}
+	if !hasDagPattern {
+		diags.AddError("Validation Error", "Alerts require at least one DAG_ID pattern in rules.pattern_matches")
+	}
+
+	// Each property belongs to a single alert type
+	properties := []struct {
+		name      string
+		isSet     bool
+		alertType string
+	}{
+		{"dag_deadline", !rules.DagDeadline.IsNull(), api.AlertTypeDagTimeliness},
+		{"dag_duration_seconds", !rules.DagDurationSeconds.IsNull(), api.AlertTypeDagDuration},
+		{"days_of_week", rules.DaysOfWeek != nil, api.AlertTypeDagTimeliness},
+		{"look_back_period_seconds", !rules.LookBackPeriodSeconds.IsNull(), api.AlertTypeDagTimeliness},
+		{"task_duration_seconds", !rules.TaskDurationSeconds.IsNull(), api.AlertTypeTaskDuration},
+	}
+	for _, property := range properties {
+		if property.isSet && property.alertType != alertType {
+			diags.AddError("Validation Error", fmt.Sprintf("rules.%s only applies to %s alerts", property.name, property.alertType))
+		}
+		if !property.isSet && property.alertType == alertType {
+			diags.AddError("Validation Error", fmt.Sprintf("%s alerts require rules.%s", alertType, property.name))
+		}
+	}
+	return diags
+}
+
+func createAlertRulesFromTFState(data AlertResourceModel) api.AlertRules {
+	rules := api.AlertRules{
+		PatternMatches: []api.AlertPatternMatch{},
+		Properties: api.AlertRulesProperties{
+			DeploymentId: data.DeploymentId.ValueString(),
+		},
+	}
+	if data.Rules == nil {
+		return rules
+	}
+
+	for _, value := range data.Rules.PatternMatches {
+		rules.PatternMatches = append(rules.PatternMatches, api.AlertPatternMatch{
+			EntityType:   value.EntityType.ValueString(),
+			OperatorType: value.OperatorType.ValueString(),
+			Values:       createStringListFromTFState(value.Values),
+		})
+	}
+	rules.Properties.DagDeadline = data.Rules.DagDeadline.ValueString()
+	rules.Properties.DagDurationSeconds = int(data.Rules.DagDurationSeconds.ValueInt64())
+	if data.Rules.DaysOfWeek != nil {
+		rules.Properties.DaysOfWeek = createStringListFromTFState(data.Rules.DaysOfWeek)
+	}
+	rules.Properties.LookBackPeriodSeconds = int(data.Rules.LookBackPeriodSeconds.ValueInt64())
+	rules.Properties.TaskDurationSeconds = int(data.Rules.TaskDurationSeconds.ValueInt64())
+	return rules
+}
+
+// loadOptionalInt64 maps the zero the API returns for unset fields to null.
+func loadOptionalInt64(value int) types.Int64 {
+	if value == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(value))
+}
+
+func loadAlertResourceFromResponse(data *AlertResourceModel, alert *api.AlertResponse) {
+	var notificationChannelIds []string
+	for _, channel := range alert.NotificationChannels {
+		notificationChannelIds = append(notificationChannelIds, channel.Id)
+	}
+	data.NotificationChannelIds = loadTFStringListKeepingOrder(data.NotificationChannelIds, notificationChannelIds)
+
+	var patternMatches []AlertPatternMatchModel = []AlertPatternMatchModel{}
+	for _, value := range alert.Rules.PatternMatches {
+		patternMatches = append(patternMatches, AlertPatternMatchModel{
+			EntityType:   types.StringValue(value.EntityType),
+			OperatorType: types.StringValue(value.OperatorType),
+			Values:       createTFStringListFromStrings(value.Values),
+		})
+	}
+	rules := &AlertRulesModel{
+		DagDeadline:           loadOptionalString(alert.Rules.Properties.DagDeadline),
+		DagDurationSeconds:    loadOptionalInt64(alert.Rules.Properties.DagDurationSeconds),
+		LookBackPeriodSeconds: loadOptionalInt64(alert.Rules.Properties.LookBackPeriodSeconds),
+		PatternMatches:        patternMatches,
+		TaskDurationSeconds:   loadOptionalInt64(alert.Rules.Properties.TaskDurationSeconds),
+	}
+	if len(alert.Rules.Properties.DaysOfWeek) > 0 {
+		rules.DaysOfWeek = createTFStringListFromStrings(alert.Rules.Properties.DaysOfWeek)
+	}
+
+	data.CreatedAt = types.StringValue(alert.CreatedAt)
+	data.DeploymentId = types.StringValue(alert.EntityId)
+	data.Id = types.StringValue(alert.Id)
+	data.Name = types.StringValue(alert.Name)
+	data.Rules = rules
+	data.Severity = types.StringValue(alert.Severity)
+	data.Type = types.StringValue(alert.Type)
+	data.UpdatedAt = types.StringValue(alert.UpdatedAt)
+}
+
+func (r *AlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AlertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(validateAlertRules(data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createRequest := &api.AlertCreateRequest{
+		EntityId:               data.DeploymentId.ValueString(),
+		EntityType:             api.AlertEntityTypeDeployment,
+		Name:                   data.Name.ValueString(),
+		NotificationChannelIds: createStringListFromTFState(data.NotificationChannelIds),
+		Rules:                  createAlertRulesFromTFState(data),
+		Severity:               data.Severity.ValueString(),
+		Type:                   data.Type.ValueString(),
+	}
+
+	alert, err := api.CreateAlert(r.token, r.organizationId, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert, got error: %s", err))
+		return
+	}
+
+	loadAlertResourceFromResponse(&data, alert)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AlertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := api.GetAlert(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert, got error: %s", err))
+		return
+	}
+
+	loadAlertResourceFromResponse(&data, alert)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AlertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(validateAlertRules(data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateRequest := &api.AlertUpdateRequest{
+		Name:                   data.Name.ValueString(),
+		NotificationChannelIds: createStringListFromTFState(data.NotificationChannelIds),
+		Rules:                  createAlertRulesFromTFState(data),
+		Severity:               data.Severity.ValueString(),
+		Type:                   data.Type.ValueString(),
+	}
+
+	alert, err := api.UpdateAlert(r.token, r.organizationId, data.Id.ValueString(), updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert, got error: %s", err))
+		return
+	}
+
+	loadAlertResourceFromResponse(&data, alert)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AlertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteAlert(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert, got error: %s", err))
+		return
+	}
+}
+
+func (r *AlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlertResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertResourceConfig("WARNING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_alert.test", "type", "DAG_DURATION"),
+					resource.TestCheckResourceAttr("astronomer_alert.test", "severity", "WARNING"),
+					resource.TestCheckResourceAttr("astronomer_alert.test", "rules.dag_duration_seconds", "3600"),
+					resource.TestCheckResourceAttr("astronomer_alert.test", "notification_channel_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("astronomer_alert.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "astronomer_alert.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
+			{
+				Config: testAccAlertResourceConfig("CRITICAL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_alert.test", "severity", "CRITICAL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAlertResourceConfig(severity string) string {
+	return testAccDeploymentConfig("TestAlertDeployment", "") + fmt.Sprintf(`
+resource "astronomer_notification_channel" "test" {
+	name = "TestAlertChannel"
+	type = "EMAIL"
+	entity_type = "DEPLOYMENT"
+	entity_id = astronomer_deployment.test.id
+	definition = {
+		recipients = ["tf-acc@example.com"]
+	}
+}
+
+resource "astronomer_alert" "test" {
+	name = "TestAlert"
+	type = "DAG_DURATION"
+	severity = %[1]q
+	deployment_id = astronomer_deployment.test.id
+	notification_channel_ids = [astronomer_notification_channel.test.id]
+	rules = {
+		dag_duration_seconds = 3600
+		pattern_matches = [
+			{
+			entity_type:   "DAG_ID",
+			operator_type: "IS",
+			values:        ["example_dag"],
+			},
+		]
+	}
+}
+`, severity)
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &AllowedIpAddressRangeResource{}
+var _ resource.ResourceWithImportState = &AllowedIpAddressRangeResource{}
+
+func NewAllowedIpAddressRangeResource() resource.Resource {
+	return &AllowedIpAddressRangeResource{}
+}
+
+type AllowedIpAddressRangeResource struct {
+	token          string
+	organizationId string
+}
+
+type AllowedIpAddressRangeResourceModel struct {
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Description    types.String `tfsdk:"description"`
+	Id             types.String `tfsdk:"id"`
+	IpAddressRange types.String `tfsdk:"ip_address_range"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (r *AllowedIpAddressRangeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allowed_ip_address_range"
+}
+
+func (r *AllowedIpAddressRangeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An IP address range allowed to reach the organization's UI and API. Once the organization has an allowed range, requests from any other address are rejected.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this range was allowed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "What the range is, e.g. `Corporate VPN egress`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The allowed IP address range's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_address_range": schema.StringAttribute{
+				MarkdownDescription: "The range to allow, as a CIDR block, e.g. `203.0.113.0/24`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					cidrValidator{},
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the range was updated.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AllowedIpAddressRangeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+func loadAllowedIpAddressRangeResourceFromResponse(data *AllowedIpAddressRangeResourceModel, ipRange *api.AllowedIpAddressRangeResponse) {
+	data.CreatedAt = types.StringValue(ipRange.CreatedAt)
+	data.Description = loadOptionalString(ipRange.Description)
+	data.Id = types.StringValue(ipRange.Id)
+	data.IpAddressRange = types.StringValue(ipRange.IpAddressRange)
+	data.UpdatedAt = types.StringValue(ipRange.UpdatedAt)
+}
+
+func (r *AllowedIpAddressRangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AllowedIpAddressRangeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createRequest := &api.AllowedIpAddressRangeCreateRequest{
+		Description:    data.Description.ValueString(),
+		IpAddressRange: data.IpAddressRange.ValueString(),
+	}
+
+	ipRange, err := api.CreateAllowedIpAddressRange(r.token, r.organizationId, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create allowed IP address range, got error: %s", err))
+		return
+	}
+
+	loadAllowedIpAddressRangeResourceFromResponse(&data, ipRange)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AllowedIpAddressRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AllowedIpAddressRangeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipRange, err := api.GetAllowedIpAddressRange(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read allowed IP address range, got error: %s", err))
+		return
+	}
+
+	// Removed outside of Terraform
+	if ipRange == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	loadAllowedIpAddressRangeResourceFromResponse(&data, ipRange)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called since every configurable attribute requires a replace.
+func (r *AllowedIpAddressRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AllowedIpAddressRangeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AllowedIpAddressRangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AllowedIpAddressRangeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteAllowedIpAddressRange(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete allowed IP address range, got error: %s", err))
+		return
+	}
+}
+
+func (r *AllowedIpAddressRangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAllowedIpAddressRangeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAllowedIpAddressRangeResourceConfig("10.0.0.1/16"),
+				ExpectError: regexp.MustCompile(`use "10.0.0.0/16" instead`),
+			},
+			{
+				Config:      testAccAllowedIpAddressRangeResourceConfig("10.0.0.0"),
+				ExpectError: regexp.MustCompile(`is not a valid CIDR block`),
+			},
+			// Allow every address so the test doesn't lock itself out of the organization
+			{
+				Config: testAccAllowedIpAddressRangeResourceConfig("0.0.0.0/0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_allowed_ip_address_range.test", "ip_address_range", "0.0.0.0/0"),
+					resource.TestCheckResourceAttrSet("astronomer_allowed_ip_address_range.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "astronomer_allowed_ip_address_range.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAllowedIpAddressRangeResourceConfig(ipAddressRange string) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_allowed_ip_address_range" "test" {
+	ip_address_range = %[2]q
+	description = "TestAccAllowedIpAddressRange"
+}
+`, orgId, ipAddressRange)
+}
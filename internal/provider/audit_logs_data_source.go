@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ datasource.DataSource = &AuditLogsDataSource{}
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{}
+}
+
+type AuditLogsDataSource struct {
+	token          string
+	organizationId string
+}
+
+type AuditLogsDataSourceModel struct {
+	EndDate    types.String   `tfsdk:"end_date"`
+	EventCount types.Int64    `tfsdk:"event_count"`
+	Events     []types.String `tfsdk:"events"`
+	OutputFile types.String   `tfsdk:"output_file"`
+	StartDate  types.String   `tfsdk:"start_date"`
+}
+
+func (d *AuditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (d *AuditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The organization's audit log events for a time window, either as a list or written to a local NDJSON file.",
+
+		Attributes: map[string]schema.Attribute{
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "End of the time window, as an RFC3339 timestamp (e.g. `2024-01-31T00:00:00Z`).",
+				Required:            true,
+			},
+			"event_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of events in the time window.",
+				Computed:            true,
+			},
+			"events": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The events as JSON documents, to be read with `jsondecode`. Empty when `output_file` is set.",
+				Computed:            true,
+			},
+			"output_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a local file to write the events to in NDJSON format, one event per line. Keeps the events out of the state.",
+				Optional:            true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Start of the time window, as an RFC3339 timestamp (e.g. `2024-01-01T00:00:00Z`).",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (d *AuditLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderDataSourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderDataSourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.token = provider.Token
+	d.organizationId = provider.OrganizationId
+}
+
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startDate, err := time.Parse(time.RFC3339, data.StartDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("start_date"), "Validation Error", fmt.Sprintf("start_date must be an RFC3339 timestamp, got error: %s", err))
+	}
+	endDate, err := time.Parse(time.RFC3339, data.EndDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end_date"), "Validation Error", fmt.Sprintf("end_date must be an RFC3339 timestamp, got error: %s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !endDate.After(startDate) {
+		resp.Diagnostics.AddAttributeError(path.Root("end_date"), "Validation Error", "end_date must be after start_date.")
+		return
+	}
+
+	events, err := api.GetAuditLogs(d.token, d.organizationId, startDate.UTC().Format(time.RFC3339), endDate.UTC().Format(time.RFC3339))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("ERROR: %s", err.Error()))
+		return
+	}
+
+	data.EventCount = types.Int64Value(int64(len(events)))
+
+	if data.OutputFile.IsNull() {
+		data.Events = createTFStringListFromStrings(events)
+	} else {
+		var content string
+		if len(events) > 0 {
+			content = strings.Join(events, "\n") + "\n"
+		}
+		if err := os.WriteFile(data.OutputFile.ValueString(), []byte(content), 0600); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("output_file"), "File Error", fmt.Sprintf("Unable to write audit logs, got error: %s", err))
+			return
+		}
+		data.Events = []types.String{}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAuditLogsDataSource(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "audit-logs.ndjson")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAuditLogsDataSourceConfig("2024-02-01T00:00:00Z", "2024-01-01T00:00:00Z", ""),
+				ExpectError: regexp.MustCompile(`end_date must be after start_date`),
+			},
+			{
+				Config: testAuditLogsDataSourceConfig("2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.astronomer_audit_logs.test", "event_count"),
+					resource.TestCheckResourceAttrPair("data.astronomer_audit_logs.test", "event_count", "data.astronomer_audit_logs.test", "events.#"),
+				),
+			},
+			{
+				Config: testAuditLogsDataSourceConfig("2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z", outputFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.astronomer_audit_logs.test", "events.#", "0"),
+					func(s *terraform.State) error {
+						_, err := os.Stat(outputFile)
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAuditLogsDataSourceConfig(startDate string, endDate string, outputFile string) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	outputFileConfig := ""
+	if outputFile != "" {
+		outputFileConfig = fmt.Sprintf("output_file = %q", outputFile)
+	}
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+data "astronomer_audit_logs" "test" {
+	start_date = %[2]q
+	end_date = %[3]q
+	%[4]s
+}
+`, orgId, startDate, endDate, outputFileConfig)
+}
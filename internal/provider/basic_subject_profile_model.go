@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+type BasicSubjectProfileModel struct {
+	APITokenName types.String `tfsdk:"api_token_name"`
+	AvatarUrl    types.String `tfsdk:"avatar_url"`
+	FullName     types.String `tfsdk:"full_name"`
+	Id           types.String `tfsdk:"id"`
+	SubjectType  types.String `tfsdk:"subject_type"`
+	Username     types.String `tfsdk:"username"`
+}
+
+func basicSubjectProfileDataSourceAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"api_token_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the API token, if the subject is an API token.",
+				Computed:            true,
+			},
+			"avatar_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the subject's avatar.",
+				Computed:            true,
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "The subject's full name.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The subject's identifier.",
+				Computed:            true,
+			},
+			"subject_type": schema.StringAttribute{
+				MarkdownDescription: "The type of subject, either `USER` or `SERVICEKEY`.",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The subject's username.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: description,
+		Computed:            true,
+	}
+}
+
+func loadBasicSubjectProfileFromResponse(profile api.BasicSubjectProfileResponse) *BasicSubjectProfileModel {
+	return &BasicSubjectProfileModel{
+		APITokenName: types.StringValue(profile.APITokenName),
+		AvatarUrl:    types.StringValue(profile.AvatarUrl),
+		FullName:     types.StringValue(profile.FullName),
+		Id:           types.StringValue(profile.Id),
+		SubjectType:  types.StringValue(profile.SubjectType),
+		Username:     types.StringValue(profile.Username),
+	}
+}
+
+func loadBasicSubjectProfileFromUser(user api.User) *BasicSubjectProfileModel {
+	return &BasicSubjectProfileModel{
+		APITokenName: types.StringValue(user.ApiTokenName),
+		AvatarUrl:    types.StringValue(user.AvatarUrl),
+		FullName:     types.StringValue(user.FullName),
+		Id:           types.StringValue(user.Id),
+		SubjectType:  types.StringValue(user.SubjectType),
+		Username:     types.StringValue(user.Username),
+	}
+}
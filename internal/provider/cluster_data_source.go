@@ -84,6 +84,22 @@ func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 			"node_pools": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"cloud_provider": schema.StringAttribute{
+							MarkdownDescription: "The cloud provider of the node pool.",
+							Computed:            true,
+						},
+						"cluster_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the cluster the node pool belongs to.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamped string of when this node pool was created.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The node pool's identifier.",
+							Computed:            true,
+						},
 						"is_default": schema.BoolAttribute{
 							MarkdownDescription: "Whether the node pool is the default node pool of the cluster.",
 							Optional:            true,
@@ -100,6 +116,15 @@ func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							MarkdownDescription: "The type of node instance that is used for the node pool.",
 							Required:            true,
 						},
+						"supported_astro_machines": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "The Astro machine types that can run on the node pool.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Last time the node pool was updated.",
+							Computed:            true,
+						},
 					},
 				},
 				MarkdownDescription: "The list of node pools to create in the cluster.",
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &ClusterNodePoolResource{}
+var _ resource.ResourceWithImportState = &ClusterNodePoolResource{}
+
+func NewClusterNodePoolResource() resource.Resource {
+	return &ClusterNodePoolResource{}
+}
+
+type ClusterNodePoolResource struct {
+	token          string
+	organizationId string
+}
+
+func (r *ClusterNodePoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_node_pool"
+}
+
+func (r *ClusterNodePoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A single node pool in a Dedicated or Hybrid cluster. The pool is merged into the cluster's existing node pools, so pools can be scaled without touching the cluster definition. Don't also declare the same pool in `node_pools` on `astronomer_cluster`.",
+
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "The cloud provider of the node pool.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster the node pool belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this node pool was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The node pool's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the node pool is the default node pool of the cluster.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_node_count": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of nodes that can be created in the node pool.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the node pool.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node_instance_type": schema.StringAttribute{
+				MarkdownDescription: "The type of node instance that is used for the node pool.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"supported_astro_machines": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The Astro machine types that can run on the node pool.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the node pool was updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ClusterNodePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+func findNodePool(nodePools []api.NodePoolResponse, name string) int {
+	return slices.IndexFunc(nodePools, func(nodePool api.NodePoolResponse) bool { return nodePool.Name == name })
+}
+
+// mergeNodePool reads the cluster, applies change to its node pools, writes them back and waits
+// for the cluster to finish updating.
+func (r *ClusterNodePoolResource) mergeNodePool(ctx context.Context, clusterId string, change func([]api.NodePoolRequest) []api.NodePoolRequest) (*api.ClusterResponse, error) {
+	unlock := lockCluster(clusterId)
+	defer unlock()
+
+	cluster, err := api.GetCluster(r.token, r.organizationId, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	// A cluster that's still updating would reject the change
+	cluster, err = waitForClusterCreated(ctx, r.token, r.organizationId, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	updateRequest := createClusterUpdateRequestFromResponse(cluster)
+	updateRequest.NodePools = change(updateRequest.NodePools)
+
+	cluster, err = api.UpdateCluster(r.token, r.organizationId, clusterId, updateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return waitForClusterCreated(ctx, r.token, r.organizationId, cluster)
+}
+
+func (r *ClusterNodePoolResource) putNodePool(ctx context.Context, data ClusterNodePoolModel) (*api.ClusterResponse, error) {
+	nodePool := api.NodePoolRequest{
+		IsDefault:        data.IsDefault.ValueBool(),
+		MaxNodeCount:     int(data.MaxNodeCount.ValueInt64()),
+		Name:             data.Name.ValueString(),
+		NodeInstanceType: data.NodeInstanceType.ValueString(),
+	}
+
+	return r.mergeNodePool(ctx, data.ClusterId.ValueString(), func(nodePools []api.NodePoolRequest) []api.NodePoolRequest {
+		idx := slices.IndexFunc(nodePools, func(value api.NodePoolRequest) bool { return value.Name == nodePool.Name })
+		if idx == -1 {
+			return append(nodePools, nodePool)
+		}
+		// Keep the pool's id so the API updates the pool instead of recreating it
+		nodePool.Id = nodePools[idx].Id
+		nodePools[idx] = nodePool
+		return nodePools
+	})
+}
+
+func loadClusterNodePoolFromResponse(data *ClusterNodePoolModel, nodePool api.NodePoolResponse) {
+	data.CloudProvider = types.StringValue(nodePool.CloudProvider)
+	data.ClusterId = types.StringValue(nodePool.ClusterId)
+	data.CreatedAt = types.StringValue(nodePool.CreatedAt)
+	data.Id = types.StringValue(nodePool.Id)
+	data.IsDefault = types.BoolValue(nodePool.IsDefault)
+	data.MaxNodeCount = types.Int64Value(int64(nodePool.MaxNodeCount))
+	data.Name = types.StringValue(nodePool.Name)
+	data.NodeInstanceType = types.StringValue(nodePool.NodeInstanceType)
+	data.SupportedAstroMachines = createTFStringListFromStrings(nodePool.SupportedAstroMachines)
+	data.UpdatedAt = types.StringValue(nodePool.UpdatedAt)
+}
+
+func (r *ClusterNodePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterNodePoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.putNodePool(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create node pool, got error: %s", err))
+		return
+	}
+
+	idx := findNodePool(cluster.NodePools, data.Name.ValueString())
+	if idx == -1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Node pool %s was not found on cluster %s after creation", data.Name.ValueString(), data.ClusterId.ValueString()))
+		return
+	}
+	loadClusterNodePoolFromResponse(&data, cluster.NodePools[idx])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterNodePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterNodePoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := api.GetCluster(r.token, r.organizationId, data.ClusterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node pool, got error: %s", err))
+		return
+	}
+
+	idx := findNodePool(cluster.NodePools, data.Name.ValueString())
+	if idx == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	loadClusterNodePoolFromResponse(&data, cluster.NodePools[idx])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterNodePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClusterNodePoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.putNodePool(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update node pool, got error: %s", err))
+		return
+	}
+
+	idx := findNodePool(cluster.NodePools, data.Name.ValueString())
+	if idx == -1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Node pool %s was not found on cluster %s after update", data.Name.ValueString(), data.ClusterId.ValueString()))
+		return
+	}
+	loadClusterNodePoolFromResponse(&data, cluster.NodePools[idx])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterNodePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterNodePoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.mergeNodePool(ctx, data.ClusterId.ValueString(), func(nodePools []api.NodePoolRequest) []api.NodePoolRequest {
+		return slices.DeleteFunc(nodePools, func(nodePool api.NodePoolRequest) bool { return nodePool.Name == data.Name.ValueString() })
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete node pool, got error: %s", err))
+		return
+	}
+}
+
+func (r *ClusterNodePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterId, name, found := strings.Cut(req.ID, "/")
+	if !found || clusterId == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: cluster_id/node_pool_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
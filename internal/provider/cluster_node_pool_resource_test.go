@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccClusterNodePoolResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterNodePoolResourceConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_cluster_node_pool.test", "name", "tf-acc"),
+					resource.TestCheckResourceAttr("astronomer_cluster_node_pool.test", "max_node_count", "2"),
+					resource.TestCheckResourceAttr("astronomer_cluster_node_pool.test", "cloud_provider", "AWS"),
+					resource.TestCheckResourceAttrSet("astronomer_cluster_node_pool.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "astronomer_cluster_node_pool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["astronomer_cluster_node_pool.test"]
+					return rs.Primary.Attributes["cluster_id"] + "/" + rs.Primary.Attributes["name"], nil
+				},
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
+			{
+				Config: testAccClusterNodePoolResourceConfig(3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_cluster_node_pool.test", "max_node_count", "3"),
+				),
+			},
+			// Leaving node_pools out of the cluster keeps the pool owned by the node pool resource
+			{
+				Config: strings.Replace(testAccClusterNodePoolResourceConfig(3), "\tnode_pools = []\n", "", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("astronomer_cluster.test", "node_pools.#"),
+					resource.TestCheckResourceAttr("astronomer_cluster_node_pool.test", "max_node_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterNodePoolResourceImportIdentifier(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %q
+}
+
+resource "astronomer_cluster_node_pool" "test" {
+	cluster_id = "cluster"
+	name = "tf-acc"
+	max_node_count = 2
+	node_instance_type = "m5.xlarge"
+}
+`, os.Getenv("ORGANIZATION_ID")),
+				ResourceName:  "astronomer_cluster_node_pool.test",
+				ImportState:   true,
+				ImportStateId: "tf-acc",
+				ExpectError:   regexp.MustCompile(`Expected import identifier with format: cluster_id/node_pool_name`),
+			},
+		},
+	})
+}
+
+func testAccClusterNodePoolResourceConfig(maxNodeCount int) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_cluster" "test" {
+	cloud_provider = "AWS"
+	name = "TestNodePoolCluster"
+	region = "us-east-1"
+	type = "DEDICATED"
+	vpc_subnet_range = "172.20.0.0/20"
+	k8s_tags = []
+	node_pools = []
+	workspace_ids = []
+}
+
+resource "astronomer_cluster_node_pool" "test" {
+	cluster_id = astronomer_cluster.test.id
+	name = "tf-acc"
+	max_node_count = %[2]d
+	node_instance_type = "m5.xlarge"
+}
+`, orgId, maxNodeCount)
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ datasource.DataSource = &ClusterOptionsDataSource{}
+
+func NewClusterOptionsDataSource() datasource.DataSource {
+	return &ClusterOptionsDataSource{}
+}
+
+type ClusterOptionsDataSource struct {
+	token          string
+	organizationId string
+}
+
+type ClusterOptionsDataSourceModel struct {
+	CloudProvider types.String                  `tfsdk:"cloud_provider"`
+	Providers     []ClusterProviderOptionsModel `tfsdk:"providers"`
+	Type          types.String                  `tfsdk:"type"`
+}
+
+type ClusterProviderOptionsModel struct {
+	CloudProvider              types.String         `tfsdk:"cloud_provider"`
+	DbInstanceTypes            []InstanceTypeModel  `tfsdk:"db_instance_types"`
+	DefaultDbInstanceType      types.String         `tfsdk:"default_db_instance_type"`
+	DefaultNodeInstanceType    types.String         `tfsdk:"default_node_instance_type"`
+	DefaultPodSubnetRange      types.String         `tfsdk:"default_pod_subnet_range"`
+	DefaultRegion              types.String         `tfsdk:"default_region"`
+	DefaultServicePeeringRange types.String         `tfsdk:"default_service_peering_range"`
+	DefaultServiceSubnetRange  types.String         `tfsdk:"default_service_subnet_range"`
+	DefaultVpcSubnetRange      types.String         `tfsdk:"default_vpc_subnet_range"`
+	NodeCount                  *RangeModel          `tfsdk:"node_count"`
+	NodeInstanceTypes          []InstanceTypeModel  `tfsdk:"node_instance_types"`
+	Regions                    []ClusterRegionModel `tfsdk:"regions"`
+}
+
+type ClusterRegionModel struct {
+	BannedInstanceTypes []types.String `tfsdk:"banned_instance_types"`
+	IsLimited           types.Bool     `tfsdk:"is_limited"`
+	Name                types.String   `tfsdk:"name"`
+}
+
+func (d *ClusterOptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_options"
+}
+
+func (d *ClusterOptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The values the API accepts when creating a cluster, per cloud provider, to validate module inputs before apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "Only return options for this cloud provider: `AWS`, `AZURE` or `GCP`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.CloudProviderAws, api.CloudProviderAzure, api.CloudProviderGcp),
+				},
+			},
+			"providers": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud_provider": schema.StringAttribute{
+							MarkdownDescription: "The cloud provider the options apply to.",
+							Computed:            true,
+						},
+						"db_instance_types": instanceTypesDataSourceAttribute("The available `db_instance_type` values."),
+						"default_db_instance_type": schema.StringAttribute{
+							MarkdownDescription: "The database instance type used by default.",
+							Computed:            true,
+						},
+						"default_node_instance_type": schema.StringAttribute{
+							MarkdownDescription: "The node instance type used by default.",
+							Computed:            true,
+						},
+						"default_pod_subnet_range": schema.StringAttribute{
+							MarkdownDescription: "The pod subnet range used by default.",
+							Computed:            true,
+						},
+						"default_region": schema.StringAttribute{
+							MarkdownDescription: "The region used by default.",
+							Computed:            true,
+						},
+						"default_service_peering_range": schema.StringAttribute{
+							MarkdownDescription: "The service peering range used by default.",
+							Computed:            true,
+						},
+						"default_service_subnet_range": schema.StringAttribute{
+							MarkdownDescription: "The service subnet range used by default.",
+							Computed:            true,
+						},
+						"default_vpc_subnet_range": schema.StringAttribute{
+							MarkdownDescription: "The VPC subnet range used by default.",
+							Computed:            true,
+						},
+						"node_count":          rangeDataSourceAttribute("The allowed `max_node_count` of node pools."),
+						"node_instance_types": instanceTypesDataSourceAttribute("The available `node_instance_type` values."),
+						"regions": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"banned_instance_types": schema.ListAttribute{
+										ElementType:         types.StringType,
+										MarkdownDescription: "The node instance types that aren't available in the region.",
+										Computed:            true,
+									},
+									"is_limited": schema.BoolAttribute{
+										MarkdownDescription: "Whether the region is limited.",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The region's name.",
+										Computed:            true,
+									},
+								},
+							},
+							MarkdownDescription: "The available regions.",
+							Computed:            true,
+						},
+					},
+				},
+				MarkdownDescription: "The options of each cloud provider.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of cluster: `DEDICATED` or `HYBRID`. Defaults to `DEDICATED`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.ClusterTypeDedicated, api.ClusterTypeHybrid),
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterOptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderDataSourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderDataSourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.token = provider.Token
+	d.organizationId = provider.OrganizationId
+}
+
+func loadClusterRegionsFromResponse(regions []api.ProviderRegion) []ClusterRegionModel {
+	var models []ClusterRegionModel = []ClusterRegionModel{}
+	for _, region := range regions {
+		models = append(models, ClusterRegionModel{
+			BannedInstanceTypes: createTFStringListFromStrings(region.BannedInstances),
+			IsLimited:           types.BoolValue(region.Limited),
+			Name:                types.StringValue(region.Name),
+		})
+	}
+	return models
+}
+
+func (d *ClusterOptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterOptionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsNull() {
+		data.Type = types.StringValue(api.ClusterTypeDedicated)
+	}
+
+	options, err := api.GetClusterOptions(d.token, d.organizationId, data.Type.ValueString(), data.CloudProvider.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("ERROR: %s", err.Error()))
+		return
+	}
+
+	var providers []ClusterProviderOptionsModel = []ClusterProviderOptionsModel{}
+	for _, option := range options {
+		providers = append(providers, ClusterProviderOptionsModel{
+			CloudProvider:              types.StringValue(option.Provider),
+			DbInstanceTypes:            loadInstanceTypesFromResponse(option.DatabaseInstances),
+			DefaultDbInstanceType:      types.StringValue(option.DefaultDatabaseInstance.Name),
+			DefaultNodeInstanceType:    types.StringValue(option.DefaultNodeInstance.Name),
+			DefaultPodSubnetRange:      types.StringValue(option.DefaultPodSubnetRange),
+			DefaultRegion:              types.StringValue(option.DefaultRegion.Name),
+			DefaultServicePeeringRange: types.StringValue(option.DefaultServicePeeringRange),
+			DefaultServiceSubnetRange:  types.StringValue(option.DefaultServiceSubnetRange),
+			DefaultVpcSubnetRange:      types.StringValue(option.DefaultVpcSubnetRange),
+			NodeCount: &RangeModel{
+				Ceiling: types.Int64Value(int64(option.NodeCountMax)),
+				Default: types.Int64Value(int64(option.NodeCountDefault)),
+				Floor:   types.Int64Value(int64(option.NodeCountMin)),
+			},
+			NodeInstanceTypes: loadInstanceTypesFromResponse(option.NodeInstances),
+			Regions:           loadClusterRegionsFromResponse(option.Regions),
+		})
+	}
+	data.Providers = providers
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
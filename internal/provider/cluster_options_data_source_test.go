@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestClusterOptionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testClusterOptionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.astronomer_cluster_options.test", "providers.#", "1"),
+					resource.TestCheckResourceAttr("data.astronomer_cluster_options.test", "providers.0.cloud_provider", "AWS"),
+					resource.TestCheckResourceAttrSet("data.astronomer_cluster_options.test", "providers.0.default_region"),
+					resource.TestCheckResourceAttrSet("data.astronomer_cluster_options.test", "providers.0.default_vpc_subnet_range"),
+					resource.TestCheckResourceAttrSet("data.astronomer_cluster_options.test", "providers.0.regions.0.name"),
+					resource.TestCheckResourceAttrSet("data.astronomer_cluster_options.test", "providers.0.node_instance_types.0.name"),
+					resource.TestCheckResourceAttrSet("data.astronomer_cluster_options.test", "providers.0.db_instance_types.0.name"),
+					resource.TestCheckResourceAttrSet("data.astronomer_cluster_options.test", "providers.0.node_count.ceiling"),
+				),
+			},
+		},
+	})
+}
+
+func testClusterOptionsDataSourceConfig() string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+data "astronomer_cluster_options" "test" {
+	cloud_provider = "AWS"
+	type = "DEDICATED"
+}
+`, orgId)
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &ClusterPrivateEndpointResource{}
+var _ resource.ResourceWithImportState = &ClusterPrivateEndpointResource{}
+
+func NewClusterPrivateEndpointResource() resource.Resource {
+	return &ClusterPrivateEndpointResource{}
+}
+
+type ClusterPrivateEndpointResource struct {
+	token          string
+	organizationId string
+}
+
+type ClusterPrivateEndpointResourceModel struct {
+	ClusterId   types.String   `tfsdk:"cluster_id"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	DnsNames    []types.String `tfsdk:"dns_names"`
+	EndpointId  types.String   `tfsdk:"endpoint_id"`
+	Id          types.String   `tfsdk:"id"`
+	IpAddress   types.String   `tfsdk:"ip_address"`
+	ServiceName types.String   `tfsdk:"service_name"`
+	Status      types.String   `tfsdk:"status"`
+	Type        types.String   `tfsdk:"type"`
+	UpdatedAt   types.String   `tfsdk:"updated_at"`
+}
+
+func (r *ClusterPrivateEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_private_endpoint"
+}
+
+func (r *ClusterPrivateEndpointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A private endpoint in a Dedicated cluster's network to a service in your own cloud account: an AWS PrivateLink interface endpoint or a GCP Private Service Connect endpoint. The endpoint stays `PENDING_ACCEPTANCE` until `endpoint_id` is accepted on the endpoint service (AWS) or service attachment (GCP).",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Dedicated cluster to create the endpoint in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this endpoint was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The DNS names Deployments in the cluster can use to reach the service.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the VPC endpoint (AWS, `vpce-...`) or the PSC connection ID (GCP) to accept on your side.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The private endpoint's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "The endpoint's IP address in the cluster's network.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_name": schema.StringAttribute{
+				MarkdownDescription: "The service to connect to: an AWS endpoint service name (`com.amazonaws.vpce.<region>.vpce-svc-...`) or a GCP service attachment (`projects/<project>/regions/<region>/serviceAttachments/<name>`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The endpoint's status, e.g. `PENDING_ACCEPTANCE` or `ACTIVE`.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "`AWS_PRIVATE_LINK` or `GCP_PRIVATE_SERVICE_CONNECT`, following the cluster's cloud provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the endpoint was updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ClusterPrivateEndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+func loadClusterPrivateEndpointResourceFromResponse(data *ClusterPrivateEndpointResourceModel, endpoint *api.PrivateEndpointResponse) {
+	data.ClusterId = types.StringValue(endpoint.ClusterId)
+	data.CreatedAt = types.StringValue(endpoint.CreatedAt)
+	data.DnsNames = createTFStringListFromStrings(endpoint.DnsNames)
+	data.EndpointId = types.StringValue(endpoint.EndpointId)
+	data.Id = types.StringValue(endpoint.Id)
+	data.IpAddress = types.StringValue(endpoint.IpAddress)
+	data.ServiceName = types.StringValue(endpoint.ServiceName)
+	data.Status = types.StringValue(endpoint.Status)
+	data.Type = types.StringValue(endpoint.Type)
+	data.UpdatedAt = types.StringValue(endpoint.UpdatedAt)
+}
+
+func (r *ClusterPrivateEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterPrivateEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := data.ClusterId.ValueString()
+
+	unlock := lockCluster(clusterId)
+	defer unlock()
+
+	cluster, err := getConnectableCluster(ctx, r.token, r.organizationId, clusterId, api.CloudProviderAws, api.CloudProviderGcp)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create private endpoint, got error: %s", err))
+		return
+	}
+
+	createRequest := &api.PrivateEndpointCreateRequest{
+		ServiceName: data.ServiceName.ValueString(),
+		Type:        api.PrivateEndpointTypeAwsPrivateLink,
+	}
+	if cluster.CloudProvider == api.CloudProviderGcp {
+		createRequest.Type = api.PrivateEndpointTypeGcpPrivateServiceConnect
+	}
+
+	endpoint, err := api.CreatePrivateEndpoint(r.token, r.organizationId, clusterId, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create private endpoint, got error: %s", err))
+		return
+	}
+
+	// The endpoint ID is only known once the endpoint leaves PROVISIONING
+	endpoint, err = waitForClusterConnection(
+		ctx,
+		func() (*api.PrivateEndpointResponse, error) {
+			return api.GetPrivateEndpoint(r.token, r.organizationId, clusterId, endpoint.Id)
+		},
+		func(endpoint *api.PrivateEndpointResponse) (string, string) {
+			return endpoint.Status, endpoint.StatusReason
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create private endpoint, got error: %s", err))
+		return
+	}
+
+	loadClusterPrivateEndpointResourceFromResponse(&data, endpoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterPrivateEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterPrivateEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint, err := api.GetPrivateEndpoint(r.token, r.organizationId, data.ClusterId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private endpoint, got error: %s", err))
+		return
+	}
+
+	loadClusterPrivateEndpointResourceFromResponse(&data, endpoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only refreshes the computed values since every configurable attribute requires a replace.
+func (r *ClusterPrivateEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClusterPrivateEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint, err := api.GetPrivateEndpoint(r.token, r.organizationId, data.ClusterId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private endpoint, got error: %s", err))
+		return
+	}
+
+	loadClusterPrivateEndpointResourceFromResponse(&data, endpoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterPrivateEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterPrivateEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeletePrivateEndpoint(r.token, r.organizationId, data.ClusterId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete private endpoint, got error: %s", err))
+		return
+	}
+}
+
+func (r *ClusterPrivateEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importClusterConnectionState(ctx, req, resp, "private_endpoint")
+}
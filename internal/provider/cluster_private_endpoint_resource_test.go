@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterPrivateEndpointResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(testAccClusterPrivateEndpointResourceConfig(), "\tservice_name = \"com.amazonaws.vpce.us-east-1.vpce-svc-0a1b2c3d4e5f67890\"\n", "", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The argument "service_name" is required`),
+			},
+		},
+	})
+}
+
+func TestAccClusterPrivateEndpointResourceImportIdentifier(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccClusterPrivateEndpointResourceConfig(),
+				ResourceName:  "astronomer_cluster_private_endpoint.test",
+				ImportState:   true,
+				ImportStateId: "endpoint",
+				ExpectError:   regexp.MustCompile(`Expected import identifier with format: cluster_id/private_endpoint_id`),
+			},
+			{
+				Config:        testAccClusterPrivateEndpointResourceConfig(),
+				ResourceName:  "astronomer_cluster_private_endpoint.test",
+				ImportState:   true,
+				ImportStateId: "/endpoint",
+				ExpectError:   regexp.MustCompile(`Expected import identifier with format: cluster_id/private_endpoint_id`),
+			},
+		},
+	})
+}
+
+func testAccClusterPrivateEndpointResourceConfig() string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_cluster_private_endpoint" "test" {
+	cluster_id = "cluster"
+	service_name = "com.amazonaws.vpce.us-east-1.vpce-svc-0a1b2c3d4e5f67890"
+}
+`, orgId)
+}
@@ -3,8 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
+	"net"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -12,12 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	api "github.com/openglshaders/astronomer-api/v2"
 )
 
 var _ resource.Resource = &ClusterResource{}
 var _ resource.ResourceWithImportState = &ClusterResource{}
+var _ resource.ResourceWithValidateConfig = &ClusterResource{}
 
 func NewClusterResource() resource.Resource {
 	return &ClusterResource{}
@@ -37,11 +42,14 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 		MarkdownDescription: "A cluster within an organization. An Astro cluster is a Kubernetes cluster that hosts the infrastructure required to run Deployments.",
 		Attributes: map[string]schema.Attribute{
 			"cloud_provider": schema.StringAttribute{
-				MarkdownDescription: "The cluster's cloud provider.",
+				MarkdownDescription: "The cluster's cloud provider. One of `AWS`, `AZURE` or `GCP`.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.CloudProviderAws, api.CloudProviderAzure, api.CloudProviderGcp),
+				},
 			},
 			"db_instance_type": schema.StringAttribute{
 				MarkdownDescription: "The type of database instance that is used for the cluster. Required for Hybrid clusters.",
@@ -104,6 +112,34 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"node_pools": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"cloud_provider": schema.StringAttribute{
+							MarkdownDescription: "The cloud provider of the node pool.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"cluster_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the cluster the node pool belongs to.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamped string of when this node pool was created.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The node pool's identifier. Can be used as a Deployment's `task_pod_node_pool_id`.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
 						"is_default": schema.BoolAttribute{
 							MarkdownDescription: "Whether the node pool is the default node pool of the cluster.",
 							Optional:            true,
@@ -120,9 +156,21 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 							MarkdownDescription: "The type of node instance that is used for the node pool.",
 							Required:            true,
 						},
+						"supported_astro_machines": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "The Astro machine types that can run on the node pool.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Last time the node pool was updated.",
+							Computed:            true,
+						},
 					},
 				},
-				MarkdownDescription: "The list of node pools to create in the cluster.",
+				MarkdownDescription: "The list of node pools to create in the cluster. Pools created with `astronomer_cluster_node_pool` are left out of this list.",
 				Optional:            true,
 			},
 			"organization_id": schema.StringAttribute{
@@ -138,6 +186,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					cidrValidator{},
+				},
 			},
 			"provider_account": schema.StringAttribute{
 				MarkdownDescription: "The provider account ID. Required for Hybrid clusters.",
@@ -154,6 +205,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					cidrValidator{},
+				},
 			},
 			"service_subnet_range": schema.StringAttribute{
 				MarkdownDescription: "The service subnet range. For GCP clusters only.",
@@ -161,6 +215,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					cidrValidator{},
+				},
 			},
 			"region": schema.StringAttribute{
 				MarkdownDescription: "The cluster's region.",
@@ -178,11 +235,14 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The cluster's type.",
+				MarkdownDescription: "The cluster's type, `DEDICATED` or `HYBRID`.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.ClusterTypeDedicated, api.ClusterTypeHybrid),
+				},
 			},
 			"vpc_subnet_range": schema.StringAttribute{
 				MarkdownDescription: "The VPC subnet range.",
@@ -190,6 +250,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					cidrValidator{},
+				},
 			},
 			"workspace_ids": schema.ListAttribute{
 				ElementType:         types.StringType,
@@ -274,6 +337,32 @@ func createNodePoolTFStateFromRequest(pools []api.NodePoolResponse) []ClusterNod
 	return nodePools
 }
 
+func filterNodePoolsByName(nodePools []ClusterNodePoolModel, names []ClusterNodePoolModel) []ClusterNodePoolModel {
+	if names == nil {
+		return nil
+	}
+	var filtered []ClusterNodePoolModel = []ClusterNodePoolModel{}
+	for _, value := range nodePools {
+		if slices.ContainsFunc(names, func(name ClusterNodePoolModel) bool { return name.Name.Equal(value.Name) }) {
+			filtered = append(filtered, value)
+		}
+	}
+	return filtered
+}
+
+// loadUnmanagedNodePools returns the cluster's node pools that are neither planned nor in prior
+// state, i.e. pools owned by astronomer_cluster_node_pool.
+func loadUnmanagedNodePools(cluster *api.ClusterResponse, plan ClusterModel, state ClusterModel) []api.NodePoolRequest {
+	var nodePools []api.NodePoolRequest
+	for _, value := range createNodePoolRequestFromResponse(cluster.NodePools) {
+		isManaged := func(nodePool ClusterNodePoolModel) bool { return nodePool.Name.ValueString() == value.Name }
+		if !slices.ContainsFunc(plan.NodePools, isManaged) && !slices.ContainsFunc(state.NodePools, isManaged) {
+			nodePools = append(nodePools, value)
+		}
+	}
+	return nodePools
+}
+
 func createStringListFromTFState(stringList []types.String) []string {
 	var strings []string = []string{}
 	for _, value := range stringList {
@@ -290,51 +379,99 @@ func createTFStringListFromStrings(stringList []string) []types.String {
 	return strings
 }
 
-func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+// clusterSubnetRangeAttributes are the ranges a cluster's network is carved from, none of them may
+// overlap.
+var clusterSubnetRangeAttributes = []string{"vpc_subnet_range", "pod_subnet_range", "service_subnet_range", "service_peering_range"}
+
+// clusterGcpOnlyAttributes can only be set on GCP clusters.
+var clusterGcpOnlyAttributes = []string{"pod_subnet_range", "service_subnet_range", "service_peering_range"}
+
+func (r *ClusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data ClusterModel
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cloud_provider"), &data.CloudProvider)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &data.Type)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("db_instance_type"), &data.DbInstanceType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tenant_id"), &data.TenantId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vpc_subnet_range"), &data.VpcSubnetRange)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pod_subnet_range"), &data.PodSubnetRange)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("service_subnet_range"), &data.ServiceSubnetRange)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("service_peering_range"), &data.ServicePeeringRange)...)
 
-	if data.Type.ValueString() == "HYBRID" {
-		if data.DbInstanceType.IsUnknown() {
-			resp.Diagnostics.AddError(
-				"Validation Error",
-				"Hybrid Clusters require a db_instance_type",
-			)
-		}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if data.CloudProvider.ValueString() == api.CloudProviderGcp {
-		if data.PodSubnetRange.ValueString() == "" {
-			resp.Diagnostics.AddError(
-				"Validation Error",
-				"GCP Clusters require a pod_subnet_range",
-			)
-		}
+	resp.Diagnostics.Append(validateClusterResourceConfig(data)...)
+}
 
-		if data.ServicePeeringRange.ValueString() == "" {
-			resp.Diagnostics.AddError(
-				"Validation Error",
-				"GCP Clusters require a service_peering_range",
-			)
-		}
+// validateClusterResourceConfig checks the settings that depend on the cluster's type and cloud
+// provider. It takes the configuration rather than the plan, so values computed by the API don't
+// trip it. Values that are still unknown are skipped, the checks run again at apply.
+func validateClusterResourceConfig(data ClusterModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ranges := map[string]types.String{
+		"vpc_subnet_range":      data.VpcSubnetRange,
+		"pod_subnet_range":      data.PodSubnetRange,
+		"service_subnet_range":  data.ServiceSubnetRange,
+		"service_peering_range": data.ServicePeeringRange,
+	}
+
+	if data.Type.ValueString() == api.ClusterTypeHybrid && data.DbInstanceType.IsNull() {
+		diags.AddAttributeError(path.Root("db_instance_type"), "Validation Error", "Hybrid Clusters require a db_instance_type")
+	}
 
-		if data.ServiceSubnetRange.ValueString() == "" {
-			resp.Diagnostics.AddError(
-				"Validation Error",
-				"GCP Clusters require a service_subnet_range",
-			)
+	switch data.CloudProvider.ValueString() {
+	case api.CloudProviderGcp:
+		for _, attribute := range clusterGcpOnlyAttributes {
+			if ranges[attribute].IsNull() {
+				diags.AddAttributeError(path.Root(attribute), "Validation Error", fmt.Sprintf("GCP Clusters require a %s", attribute))
+			}
+		}
+	case api.CloudProviderAws, api.CloudProviderAzure:
+		for _, attribute := range clusterGcpOnlyAttributes {
+			if !ranges[attribute].IsNull() {
+				diags.AddAttributeError(path.Root(attribute), "Validation Error", fmt.Sprintf("%s is only available for GCP Clusters.", attribute))
+			}
 		}
 	}
 
-	if data.CloudProvider.ValueString() == api.CloudProviderAzure {
-		if data.TenantId.ValueString() == "" {
-			resp.Diagnostics.AddError(
-				"Validation Error",
-				"Azure Clusters require a tenant_id",
-			)
+	if data.CloudProvider.ValueString() == api.CloudProviderAzure && data.TenantId.IsNull() {
+		diags.AddAttributeError(path.Root("tenant_id"), "Validation Error", "Azure Clusters require a tenant_id")
+	}
+
+	// Parse errors are reported by cidrValidator, only the ranges that parse are compared.
+	networks := map[string]*net.IPNet{}
+	for _, attribute := range clusterSubnetRangeAttributes {
+		value := ranges[attribute]
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, network, err := net.ParseCIDR(value.ValueString()); err == nil {
+			networks[attribute] = network
+		}
+	}
+	for i, attribute := range clusterSubnetRangeAttributes {
+		for _, other := range clusterSubnetRangeAttributes[i+1:] {
+			a, b := networks[attribute], networks[other]
+			if a == nil || b == nil {
+				continue
+			}
+			if a.Contains(b.IP) || b.Contains(a.IP) {
+				diags.AddAttributeError(path.Root(other), "Validation Error", fmt.Sprintf("%s %s overlaps %s %s.", other, b, attribute, a))
+			}
 		}
 	}
+	return diags
+}
+
+func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterModel
+	var config ClusterModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(validateClusterResourceConfig(config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -372,9 +509,10 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	for createResponse.Status != api.ClusterStatusCreated {
-		createResponse, _ = api.GetCluster(r.token, r.organizationId, createResponse.Id)
-		time.Sleep(1 * time.Second)
+	createResponse, err = waitForClusterCreated(ctx, r.token, r.organizationId, createResponse)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create example, got error: %s", err))
+		return
 	}
 
 	// Load GCP Specific Data Points
@@ -396,7 +534,7 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 	data.Metadata, _ = getMetadata(createResponse)
 	data.K8sTags = createK8sTagTFStateFromRequest(createResponse.Tags)
 	data.Name = types.StringValue(createResponse.Name)
-	data.NodePools = createNodePoolTFStateFromRequest(createResponse.NodePools)
+	data.NodePools = filterNodePoolsByName(createNodePoolTFStateFromRequest(createResponse.NodePools), data.NodePools)
 	data.OrganizationId = types.StringValue(createResponse.OrganizationId)
 	data.ProviderAccount = types.StringValue(createResponse.ProviderAccount)
 	data.Region = types.StringValue(createResponse.Region)
@@ -427,8 +565,14 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data.IsLimited = types.BoolValue(clusterResponse.IsLimited)
 	data.Metadata, _ = getMetadata(clusterResponse)
 	data.K8sTags = createK8sTagTFStateFromRequest(clusterResponse.Tags)
+	// Pools missing from state are left to astronomer_cluster_node_pool, unless the cluster is
+	// being imported
+	nodePools := createNodePoolTFStateFromRequest(clusterResponse.NodePools)
+	if !data.Name.IsNull() {
+		nodePools = filterNodePoolsByName(nodePools, data.NodePools)
+	}
+	data.NodePools = nodePools
 	data.Name = types.StringValue(clusterResponse.Name)
-	data.NodePools = createNodePoolTFStateFromRequest(clusterResponse.NodePools)
 	data.OrganizationId = types.StringValue(clusterResponse.OrganizationId)
 	data.PodSubnetRange = types.StringValue(clusterResponse.PodSubnetRange)
 	data.ProviderAccount = types.StringValue(clusterResponse.ProviderAccount)
@@ -449,35 +593,57 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data ClusterModel
+	var state ClusterModel
+	var config ClusterModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(validateClusterResourceConfig(config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	unlock := lockCluster(data.Id.ValueString())
+	defer unlock()
+
+	cluster, err := api.GetCluster(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster, got error: %s", err))
+		return
+	}
+
 	clusterUpdateRequest := &api.ClusterUpdateRequest{
 		DbInstanceType: data.DbInstanceType.ValueString(),
 		K8sTags:        createK8sTagRequestFromTFState(data),
 		Name:           data.Name.ValueString(),
-		NodePools:      createNodePoolRequestFromTFState(data),
+		NodePools:      append(createNodePoolRequestFromTFState(data), loadUnmanagedNodePools(cluster, data, state)...),
 		WorkspaceIds:   createStringListFromTFState(data.WorkspaceIds),
 	}
 
+	// Send existing pools' ids so the API updates them instead of recreating them
+	for i, nodePool := range clusterUpdateRequest.NodePools {
+		if idx := slices.IndexFunc(cluster.NodePools, func(value api.NodePoolResponse) bool { return value.Name == nodePool.Name }); idx != -1 {
+			clusterUpdateRequest.NodePools[i].Id = cluster.NodePools[idx].Id
+		}
+	}
+
 	clusterResponse, err := api.UpdateCluster(r.token, r.organizationId, data.Id.ValueString(), clusterUpdateRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
 		return
 	}
 
-	for clusterResponse.Status != api.ClusterStatusCreated {
-		clusterResponse, _ = api.GetCluster(r.token, r.organizationId, clusterResponse.Id)
-		time.Sleep(1 * time.Second)
+	clusterResponse, err = waitForClusterCreated(ctx, r.token, r.organizationId, clusterResponse)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
+		return
 	}
 
 	data.DbInstanceType = types.StringValue(clusterResponse.DbInstanceType)
 	data.K8sTags = createK8sTagTFStateFromRequest(clusterResponse.Tags)
 	data.Name = types.StringValue(clusterResponse.Name)
-	data.NodePools = createNodePoolTFStateFromRequest(clusterResponse.NodePools)
+	data.NodePools = filterNodePoolsByName(createNodePoolTFStateFromRequest(clusterResponse.NodePools), data.NodePools)
 	data.WorkspaceIds = createTFStringListFromStrings(clusterResponse.WorkspaceIds)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &ClusterRouteResource{}
+var _ resource.ResourceWithImportState = &ClusterRouteResource{}
+
+func NewClusterRouteResource() resource.Resource {
+	return &ClusterRouteResource{}
+}
+
+type ClusterRouteResource struct {
+	token          string
+	organizationId string
+}
+
+type ClusterRouteResourceModel struct {
+	ClusterId       types.String   `tfsdk:"cluster_id"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	DestinationCidr types.String   `tfsdk:"destination_cidr"`
+	Id              types.String   `tfsdk:"id"`
+	RouteTableIds   []types.String `tfsdk:"route_table_ids"`
+	Status          types.String   `tfsdk:"status"`
+	TargetId        types.String   `tfsdk:"target_id"`
+	UpdatedAt       types.String   `tfsdk:"updated_at"`
+}
+
+func (r *ClusterRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_route"
+}
+
+func (r *ClusterRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A route in the route tables of a Dedicated AWS cluster, sending traffic for a CIDR block through a VPC peering or transit gateway. GCP clusters exchange routes over the peering and don't need explicit routes.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Dedicated cluster to add the route to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this route was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destination_cidr": schema.StringAttribute{
+				MarkdownDescription: "The CIDR block to route, e.g. the CIDR of the peered VPC.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					cidrValidator{},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The route's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"route_table_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the cluster's route tables the route was added to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The route's status, e.g. `ACTIVE`.",
+				Computed:            true,
+			},
+			"target_id": schema.StringAttribute{
+				MarkdownDescription: "Where to send the traffic: a peering connection ID (`pcx-...`, see `peering_id` on `astronomer_cluster_vpc_peering`) or a transit gateway ID (`tgw-...`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the route was updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ClusterRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+func loadClusterRouteResourceFromResponse(data *ClusterRouteResourceModel, route *api.ClusterRouteResponse) {
+	data.ClusterId = types.StringValue(route.ClusterId)
+	data.CreatedAt = types.StringValue(route.CreatedAt)
+	data.DestinationCidr = types.StringValue(route.DestinationCidr)
+	data.Id = types.StringValue(route.Id)
+	data.RouteTableIds = createTFStringListFromStrings(route.RouteTableIds)
+	data.Status = types.StringValue(route.Status)
+	data.TargetId = types.StringValue(route.TargetId)
+	data.UpdatedAt = types.StringValue(route.UpdatedAt)
+}
+
+func (r *ClusterRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterRouteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := data.ClusterId.ValueString()
+
+	unlock := lockCluster(clusterId)
+	defer unlock()
+
+	_, err := getConnectableCluster(ctx, r.token, r.organizationId, clusterId, api.CloudProviderAws)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create route, got error: %s", err))
+		return
+	}
+
+	createRequest := &api.ClusterRouteCreateRequest{
+		DestinationCidr: data.DestinationCidr.ValueString(),
+		TargetId:        data.TargetId.ValueString(),
+	}
+
+	route, err := api.CreateClusterRoute(r.token, r.organizationId, clusterId, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create route, got error: %s", err))
+		return
+	}
+
+	route, err = waitForClusterConnection(
+		ctx,
+		func() (*api.ClusterRouteResponse, error) {
+			return api.GetClusterRoute(r.token, r.organizationId, clusterId, route.Id)
+		},
+		func(route *api.ClusterRouteResponse) (string, string) { return route.Status, route.StatusReason },
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create route, got error: %s", err))
+		return
+	}
+
+	loadClusterRouteResourceFromResponse(&data, route)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterRouteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := api.GetClusterRoute(r.token, r.organizationId, data.ClusterId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read route, got error: %s", err))
+		return
+	}
+
+	loadClusterRouteResourceFromResponse(&data, route)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only refreshes the computed values since every configurable attribute requires a replace.
+func (r *ClusterRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClusterRouteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := api.GetClusterRoute(r.token, r.organizationId, data.ClusterId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read route, got error: %s", err))
+		return
+	}
+
+	loadClusterRouteResourceFromResponse(&data, route)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterRouteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteClusterRoute(r.token, r.organizationId, data.ClusterId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete route, got error: %s", err))
+		return
+	}
+}
+
+func (r *ClusterRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importClusterConnectionState(ctx, req, resp, "route")
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterRouteResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterRouteResourceConfig("10.100.0.0"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is not a valid CIDR block`),
+			},
+			{
+				Config:      testAccClusterRouteResourceConfig("10.100.0.1/16"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`has host bits set`),
+			},
+		},
+	})
+}
+
+func TestAccClusterRouteResourceImportIdentifier(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccClusterRouteResourceConfig("10.100.0.0/16"),
+				ResourceName:  "astronomer_cluster_route.test",
+				ImportState:   true,
+				ImportStateId: "route",
+				ExpectError:   regexp.MustCompile(`Expected import identifier with format: cluster_id/route_id`),
+			},
+			{
+				Config:        testAccClusterRouteResourceConfig("10.100.0.0/16"),
+				ResourceName:  "astronomer_cluster_route.test",
+				ImportState:   true,
+				ImportStateId: "cluster/",
+				ExpectError:   regexp.MustCompile(`Expected import identifier with format: cluster_id/route_id`),
+			},
+		},
+	})
+}
+
+func testAccClusterRouteResourceConfig(destinationCidr string) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_cluster_route" "test" {
+	cluster_id = "cluster"
+	destination_cidr = %[2]q
+	target_id = "pcx-0a1b2c3d4e5f67890"
+}
+`, orgId, destinationCidr)
+}
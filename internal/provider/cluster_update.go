@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+// UpdateCluster replaces every node pool of the cluster, so astronomer_cluster_node_pool reads the
+// current cluster, merges its pool and writes it back. The lock serializes those read-merge-writes
+// within a single apply.
+var clusterLocks sync.Map
+
+func lockCluster(clusterId string) func() {
+	value, _ := clusterLocks.LoadOrStore(clusterId, &sync.Mutex{})
+	mutex := value.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+func createNodePoolRequestFromResponse(pools []api.NodePoolResponse) []api.NodePoolRequest {
+	var nodePools []api.NodePoolRequest = []api.NodePoolRequest{}
+	for _, value := range pools {
+		nodePools = append(nodePools, api.NodePoolRequest{
+			Id:               value.Id,
+			IsDefault:        value.IsDefault,
+			MaxNodeCount:     value.MaxNodeCount,
+			Name:             value.Name,
+			NodeInstanceType: value.NodeInstanceType,
+		})
+	}
+	return nodePools
+}
+
+func createClusterUpdateRequestFromResponse(cluster *api.ClusterResponse) *api.ClusterUpdateRequest {
+	return &api.ClusterUpdateRequest{
+		DbInstanceType: cluster.DbInstanceType,
+		K8sTags:        cluster.Tags,
+		Name:           cluster.Name,
+		NodePools:      createNodePoolRequestFromResponse(cluster.NodePools),
+		WorkspaceIds:   cluster.WorkspaceIds,
+	}
+}
+
+// Creating a cluster provisions its cloud resources, which takes up to an hour.
+const (
+	clusterCreatedTimeout = 90 * time.Minute
+	clusterPollInterval   = 10 * time.Second
+)
+
+// waitForClusterCreated polls the cluster until it has finished creating or updating. Any of the
+// *_FAILED statuses ends the wait.
+func waitForClusterCreated(ctx context.Context, token string, organizationId string, cluster *api.ClusterResponse) (*api.ClusterResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, clusterCreatedTimeout)
+	defer cancel()
+
+	var err error
+	for cluster.Status != api.ClusterStatusCreated {
+		if strings.HasSuffix(cluster.Status, "_FAILED") {
+			return nil, fmt.Errorf("Cluster %s is in status %s: %s", cluster.Id, cluster.Status, cluster.StatusReason)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Cluster %s is still in status %s: %s", cluster.Id, cluster.Status, ctx.Err())
+		case <-time.After(clusterPollInterval):
+		}
+		cluster, err = api.GetCluster(token, organizationId, cluster.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cluster, nil
+}
+
+// getConnectableCluster returns the cluster once it has finished updating, if it's a Dedicated
+// cluster on one of the cloud providers. Peerings, private endpoints and routes can only be added
+// to clusters in Astro's own cloud accounts.
+func getConnectableCluster(ctx context.Context, token string, organizationId string, clusterId string, cloudProviders ...string) (*api.ClusterResponse, error) {
+	cluster, err := api.GetCluster(token, organizationId, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Type != api.ClusterTypeDedicated || !slices.Contains(cloudProviders, cluster.CloudProvider) {
+		return nil, fmt.Errorf("Cluster %s is a %s %s cluster, expected a %s cluster on %s", cluster.Id, cluster.CloudProvider, cluster.Type, api.ClusterTypeDedicated, strings.Join(cloudProviders, " or "))
+	}
+	return waitForClusterCreated(ctx, token, organizationId, cluster)
+}
+
+// Provisioning a connection only sets up Astro's side of it, which takes a few minutes.
+const (
+	clusterConnectionTimeout      = 30 * time.Minute
+	clusterConnectionPollInterval = 5 * time.Second
+)
+
+// waitForClusterConnection polls get until the connection is no longer provisioning. Peerings and
+// private endpoints then wait for acceptance on the customer's side, which Terraform can't wait on.
+func waitForClusterConnection[T any](ctx context.Context, get func() (*T, error), status func(*T) (string, string)) (*T, error) {
+	ctx, cancel := context.WithTimeout(ctx, clusterConnectionTimeout)
+	defer cancel()
+
+	for {
+		connection, err := get()
+		if err != nil {
+			return nil, err
+		}
+		switch current, reason := status(connection); current {
+		case api.ClusterConnectionStatusProvisioning:
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("Connection is still in status %s: %s", current, ctx.Err())
+			case <-time.After(clusterConnectionPollInterval):
+			}
+		case api.ClusterConnectionStatusFailed:
+			return nil, fmt.Errorf("Connection is in status %s: %s", current, reason)
+		default:
+			return connection, nil
+		}
+	}
+}
+
+func importClusterConnectionState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, kind string) {
+	clusterId, id, found := strings.Cut(req.ID, "/")
+	if !found || clusterId == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: cluster_id/%s_id. Got: %q", kind, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
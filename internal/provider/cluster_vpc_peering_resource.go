@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &ClusterVpcPeeringResource{}
+var _ resource.ResourceWithImportState = &ClusterVpcPeeringResource{}
+
+func NewClusterVpcPeeringResource() resource.Resource {
+	return &ClusterVpcPeeringResource{}
+}
+
+type ClusterVpcPeeringResource struct {
+	token          string
+	organizationId string
+}
+
+type ClusterVpcPeeringResourceModel struct {
+	AstroNetworkId types.String `tfsdk:"astro_network_id"`
+	AstroProjectId types.String `tfsdk:"astro_project_id"`
+	ClusterId      types.String `tfsdk:"cluster_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Id             types.String `tfsdk:"id"`
+	PeerAccountId  types.String `tfsdk:"peer_account_id"`
+	PeerNetworkId  types.String `tfsdk:"peer_network_id"`
+	PeerProjectId  types.String `tfsdk:"peer_project_id"`
+	PeerRegion     types.String `tfsdk:"peer_region"`
+	PeeringId      types.String `tfsdk:"peering_id"`
+	Status         types.String `tfsdk:"status"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (r *ClusterVpcPeeringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_vpc_peering"
+}
+
+func (r *ClusterVpcPeeringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A VPC peering request from a Dedicated AWS or GCP cluster to a network in your own cloud account. The peering stays `PENDING_ACCEPTANCE` until it's accepted on your side, e.g. with `aws_vpc_peering_connection_accepter` using `peering_id`, or a `google_compute_network_peering` to `astro_network_id` in `astro_project_id`.",
+
+		Attributes: map[string]schema.Attribute{
+			"astro_network_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster's VPC (AWS) or the name of its VPC network (GCP).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"astro_project_id": schema.StringAttribute{
+				MarkdownDescription: "The GCP project of the cluster's VPC network. Only set for GCP clusters.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Dedicated cluster to peer from.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this peering was requested.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The VPC peering's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_account_id": schema.StringAttribute{
+				MarkdownDescription: "The AWS account ID of the VPC to peer with. Required for AWS clusters.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_network_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the VPC (AWS) or the name of the VPC network (GCP) to peer with.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_project_id": schema.StringAttribute{
+				MarkdownDescription: "The GCP project of the VPC network to peer with. Required for GCP clusters.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_region": schema.StringAttribute{
+				MarkdownDescription: "The AWS region of the VPC to peer with. Defaults to the cluster's region. Only for AWS clusters.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peering_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the peering connection (AWS, `pcx-...`) or the name of the peering (GCP) to accept in your cloud account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The peering's status, e.g. `PENDING_ACCEPTANCE` or `ACTIVE`.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the peering was updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ClusterVpcPeeringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+func loadClusterVpcPeeringResourceFromResponse(data *ClusterVpcPeeringResourceModel, peering *api.VpcPeeringResponse) {
+	data.AstroNetworkId = types.StringValue(peering.AstroNetworkId)
+	data.AstroProjectId = loadOptionalString(peering.AstroProjectId)
+	data.ClusterId = types.StringValue(peering.ClusterId)
+	data.CreatedAt = types.StringValue(peering.CreatedAt)
+	data.Id = types.StringValue(peering.Id)
+	data.PeerAccountId = loadOptionalString(peering.PeerAccountId)
+	data.PeerNetworkId = types.StringValue(peering.PeerNetworkId)
+	data.PeerProjectId = loadOptionalString(peering.PeerProjectId)
+	data.PeerRegion = types.StringValue(peering.PeerRegion)
+	data.PeeringId = types.StringValue(peering.PeeringId)
+	data.Status = types.StringValue(peering.Status)
+	data.UpdatedAt = types.StringValue(peering.UpdatedAt)
+}
+
+func validateClusterVpcPeering(data ClusterVpcPeeringResourceModel, cluster *api.ClusterResponse) error {
+	switch cluster.CloudProvider {
+	case api.CloudProviderAws:
+		if data.PeerAccountId.IsNull() {
+			return fmt.Errorf("peer_account_id is required to peer with an AWS cluster")
+		}
+		if !data.PeerProjectId.IsNull() {
+			return fmt.Errorf("peer_project_id can only be set to peer with a GCP cluster")
+		}
+	case api.CloudProviderGcp:
+		if data.PeerProjectId.IsNull() {
+			return fmt.Errorf("peer_project_id is required to peer with a GCP cluster")
+		}
+		// peer_region is unknown unless configured, since it defaults to the cluster's region
+		if !data.PeerAccountId.IsNull() || !data.PeerRegion.IsUnknown() {
+			return fmt.Errorf("peer_account_id and peer_region can only be set to peer with an AWS cluster")
+		}
+	}
+	return nil
+}
+
+func (r *ClusterVpcPeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterVpcPeeringResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := data.ClusterId.ValueString()
+
+	unlock := lockCluster(clusterId)
+	defer unlock()
+
+	cluster, err := getConnectableCluster(ctx, r.token, r.organizationId, clusterId, api.CloudProviderAws, api.CloudProviderGcp)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create VPC peering, got error: %s", err))
+		return
+	}
+
+	if err := validateClusterVpcPeering(data, cluster); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	createRequest := &api.VpcPeeringCreateRequest{
+		PeerAccountId: data.PeerAccountId.ValueString(),
+		PeerNetworkId: data.PeerNetworkId.ValueString(),
+		PeerProjectId: data.PeerProjectId.ValueString(),
+		PeerRegion:    data.PeerRegion.ValueString(),
+	}
+
+	peering, err := api.CreateVpcPeering(r.token, r.organizationId, clusterId, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create VPC peering, got error: %s", err))
+		return
+	}
+
+	// The peering ID is only known once the peering leaves PROVISIONING
+	peering, err = waitForClusterConnection(
+		ctx,
+		func() (*api.VpcPeeringResponse, error) {
+			return api.GetVpcPeering(r.token, r.organizationId, clusterId, peering.Id)
+		},
+		func(peering *api.VpcPeeringResponse) (string, string) { return peering.Status, peering.StatusReason },
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create VPC peering, got error: %s", err))
+		return
+	}
+
+	loadClusterVpcPeeringResourceFromResponse(&data, peering)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterVpcPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterVpcPeeringResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peering, err := api.GetVpcPeering(r.token, r.organizationId, data.ClusterId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read VPC peering, got error: %s", err))
+		return
+	}
+
+	loadClusterVpcPeeringResourceFromResponse(&data, peering)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only refreshes the computed values since every configurable attribute requires a replace.
+func (r *ClusterVpcPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClusterVpcPeeringResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peering, err := api.GetVpcPeering(r.token, r.organizationId, data.ClusterId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read VPC peering, got error: %s", err))
+		return
+	}
+
+	loadClusterVpcPeeringResourceFromResponse(&data, peering)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterVpcPeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterVpcPeeringResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteVpcPeering(r.token, r.organizationId, data.ClusterId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete VPC peering, got error: %s", err))
+		return
+	}
+}
+
+func (r *ClusterVpcPeeringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importClusterConnectionState(ctx, req, resp, "vpc_peering")
+}
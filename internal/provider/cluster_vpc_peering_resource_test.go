@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+func TestValidateClusterVpcPeering(t *testing.T) {
+	tests := []struct {
+		name          string
+		cloudProvider string
+		data          ClusterVpcPeeringResourceModel
+		err           string
+	}{
+		{
+			name:          "aws",
+			cloudProvider: api.CloudProviderAws,
+			data:          ClusterVpcPeeringResourceModel{PeerAccountId: types.StringValue("123456789012"), PeerProjectId: types.StringNull(), PeerRegion: types.StringUnknown()},
+		},
+		{
+			name:          "aws without account",
+			cloudProvider: api.CloudProviderAws,
+			data:          ClusterVpcPeeringResourceModel{PeerAccountId: types.StringNull(), PeerProjectId: types.StringNull(), PeerRegion: types.StringUnknown()},
+			err:           "peer_account_id is required",
+		},
+		{
+			name:          "aws with project",
+			cloudProvider: api.CloudProviderAws,
+			data:          ClusterVpcPeeringResourceModel{PeerAccountId: types.StringValue("123456789012"), PeerProjectId: types.StringValue("project"), PeerRegion: types.StringUnknown()},
+			err:           "peer_project_id can only be set",
+		},
+		{
+			name:          "gcp",
+			cloudProvider: api.CloudProviderGcp,
+			data:          ClusterVpcPeeringResourceModel{PeerAccountId: types.StringNull(), PeerProjectId: types.StringValue("project"), PeerRegion: types.StringUnknown()},
+		},
+		{
+			name:          "gcp without project",
+			cloudProvider: api.CloudProviderGcp,
+			data:          ClusterVpcPeeringResourceModel{PeerAccountId: types.StringNull(), PeerProjectId: types.StringNull(), PeerRegion: types.StringUnknown()},
+			err:           "peer_project_id is required",
+		},
+		{
+			name:          "gcp with region",
+			cloudProvider: api.CloudProviderGcp,
+			data:          ClusterVpcPeeringResourceModel{PeerAccountId: types.StringNull(), PeerProjectId: types.StringValue("project"), PeerRegion: types.StringValue("us-east-1")},
+			err:           "peer_account_id and peer_region can only be set",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateClusterVpcPeering(test.data, &api.ClusterResponse{CloudProvider: test.cloudProvider})
+			if test.err == "" && err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+			if test.err != "" && (err == nil || !regexp.MustCompile(test.err).MatchString(err.Error())) {
+				t.Fatalf("expected error matching %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestAccClusterVpcPeeringResourceImportIdentifier(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccClusterVpcPeeringResourceConfig(),
+				ResourceName:  "astronomer_cluster_vpc_peering.test",
+				ImportState:   true,
+				ImportStateId: "peering",
+				ExpectError:   regexp.MustCompile(`Expected import identifier with format: cluster_id/vpc_peering_id`),
+			},
+			{
+				Config:        testAccClusterVpcPeeringResourceConfig(),
+				ResourceName:  "astronomer_cluster_vpc_peering.test",
+				ImportState:   true,
+				ImportStateId: "cluster/",
+				ExpectError:   regexp.MustCompile(`Expected import identifier with format: cluster_id/vpc_peering_id`),
+			},
+		},
+	})
+}
+
+func testAccClusterVpcPeeringResourceConfig() string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_cluster_vpc_peering" "test" {
+	cluster_id = "cluster"
+	peer_account_id = "123456789012"
+	peer_network_id = "vpc-0a1b2c3d4e5f67890"
+}
+`, orgId)
+}
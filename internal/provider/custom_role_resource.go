@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &CustomRoleResource{}
+var _ resource.ResourceWithImportState = &CustomRoleResource{}
+var _ resource.ResourceWithModifyPlan = &CustomRoleResource{}
+
+func NewCustomRoleResource() resource.Resource {
+	return &CustomRoleResource{}
+}
+
+type CustomRoleResource struct {
+	token          string
+	organizationId string
+}
+
+type CustomRoleResourceModel struct {
+	CreatedAt              types.String   `tfsdk:"created_at"`
+	Description            types.String   `tfsdk:"description"`
+	Id                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	Permissions            []types.String `tfsdk:"permissions"`
+	RestrictedWorkspaceIds []types.String `tfsdk:"restricted_workspace_ids"`
+	ScopeType              types.String   `tfsdk:"scope_type"`
+	UpdatedAt              types.String   `tfsdk:"updated_at"`
+}
+
+func (r *CustomRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_role"
+}
+
+func (r *CustomRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A custom role with a hand-picked set of permissions, e.g. a least-privilege Deployment role for CI API tokens.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this role was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The role's description.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The role's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The role's name.",
+				Required:            true,
+			},
+			"permissions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The permissions granted by the role, e.g. `deployment.apiTokens.get`. Checked against `astronomer_permission_groups` when planning.",
+				Required:            true,
+			},
+			"restricted_workspace_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the workspaces the role can be used in. Leave unset to allow every workspace.",
+				Optional:            true,
+			},
+			"scope_type": schema.StringAttribute{
+				MarkdownDescription: "The scope the role applies to. Defaults to `DEPLOYMENT`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(api.RoleScopeTypeDeployment),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the role was updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CustomRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+// ModifyPlan checks the permissions against the permission catalogue of the scope type. It runs
+// here rather than in ValidateConfig because the provider isn't configured during validation.
+func (r *CustomRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() || r.token == "" {
+		return
+	}
+
+	var scopeType types.String
+	var permissions types.List
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("scope_type"), &scopeType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+
+	if resp.Diagnostics.HasError() || scopeType.IsUnknown() || permissions.IsUnknown() {
+		return
+	}
+
+	groups, err := api.GetPermissionGroups(r.token, r.organizationId, scopeType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission groups, got error: %s", err))
+		return
+	}
+	permissionNames := getPermissionNames(groups)
+
+	for idx, element := range permissions.Elements() {
+		permission, ok := element.(types.String)
+		if !ok || permission.IsUnknown() || slices.Contains(permissionNames, permission.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("permissions").AtListIndex(idx),
+			"Validation Error",
+			fmt.Sprintf("%q is not a %s permission. See the astronomer_permission_groups data source for valid permissions.", permission.ValueString(), scopeType.ValueString()),
+		)
+	}
+}
+
+func loadCustomRoleResourceFromResponse(data *CustomRoleResourceModel, role *api.RoleResponse) {
+	data.CreatedAt = types.StringValue(role.CreatedAt)
+	data.Description = loadOptionalString(role.Description)
+	data.Id = types.StringValue(role.Id)
+	data.Name = types.StringValue(role.Name)
+	data.Permissions = loadTFStringListKeepingOrder(data.Permissions, role.Permissions)
+	data.RestrictedWorkspaceIds = loadTFStringListKeepingOrder(data.RestrictedWorkspaceIds, role.RestrictedWorkspaceIds)
+	data.ScopeType = types.StringValue(role.ScopeType)
+	data.UpdatedAt = types.StringValue(role.UpdatedAt)
+}
+
+func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomRoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createRequest := &api.RoleCreateRequest{
+		Description:            data.Description.ValueString(),
+		Name:                   data.Name.ValueString(),
+		Permissions:            createStringListFromTFState(data.Permissions),
+		RestrictedWorkspaceIds: createStringListFromTFState(data.RestrictedWorkspaceIds),
+		ScopeType:              data.ScopeType.ValueString(),
+	}
+
+	role, err := api.CreateRole(r.token, r.organizationId, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom role, got error: %s", err))
+		return
+	}
+
+	loadCustomRoleResourceFromResponse(&data, role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomRoleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := api.GetRole(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom role, got error: %s", err))
+		return
+	}
+
+	loadCustomRoleResourceFromResponse(&data, role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomRoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateRequest := &api.RoleUpdateRequest{
+		Description:            data.Description.ValueString(),
+		Name:                   data.Name.ValueString(),
+		Permissions:            createStringListFromTFState(data.Permissions),
+		RestrictedWorkspaceIds: createStringListFromTFState(data.RestrictedWorkspaceIds),
+	}
+
+	role, err := api.UpdateRole(r.token, r.organizationId, data.Id.ValueString(), updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom role, got error: %s", err))
+		return
+	}
+
+	loadCustomRoleResourceFromResponse(&data, role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomRoleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteRole(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom role, got error: %s", err))
+		return
+	}
+}
+
+func (r *CustomRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomRoleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCustomRoleResourceConfig("TestCustomRole", "deployment.notAPermission"),
+				ExpectError: regexp.MustCompile(`is not a DEPLOYMENT permission`),
+			},
+			{
+				Config: testAccCustomRoleResourceConfig("TestCustomRole", "deployment.get"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_custom_role.test", "name", "TestCustomRole"),
+					resource.TestCheckResourceAttr("astronomer_custom_role.test", "scope_type", "DEPLOYMENT"),
+					resource.TestCheckResourceAttr("astronomer_custom_role.test", "permissions.#", "1"),
+					resource.TestCheckResourceAttrSet("astronomer_custom_role.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "astronomer_custom_role.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
+			{
+				Config: testAccCustomRoleResourceConfig("TestCustomRoleUpdate", "deployment.get"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_custom_role.test", "name", "TestCustomRoleUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomRoleResourceConfig(name string, permission string) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_custom_role" "test" {
+	name = %[2]q
+	description = "TestAccCustomRole"
+	permissions = [%[3]q]
+}
+`, orgId, name, permission)
+}
@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &DeploymentApiTokenResource{}
+var _ resource.ResourceWithImportState = &DeploymentApiTokenResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentApiTokenResource{}
+
+func NewDeploymentApiTokenResource() resource.Resource {
+	return &DeploymentApiTokenResource{}
+}
+
+type DeploymentApiTokenResource struct {
+	token          string
+	organizationId string
+}
+
+type DeploymentApiTokenResourceModel struct {
+	CreatedAt           types.String `tfsdk:"created_at"`
+	DeploymentId        types.String `tfsdk:"deployment_id"`
+	Description         types.String `tfsdk:"description"`
+	EndAt               types.String `tfsdk:"end_at"`
+	ExpiryPeriodInDays  types.Int64  `tfsdk:"expiry_period_in_days"`
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	RenewalWindowInDays types.Int64  `tfsdk:"renewal_window_in_days"`
+	Role                types.String `tfsdk:"role"`
+	ShortToken          types.String `tfsdk:"short_token"`
+	Token               types.String `tfsdk:"token"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+}
+
+func (r *DeploymentApiTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_api_token"
+}
+
+func (r *DeploymentApiTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An API token scoped to a single Deployment, e.g. for deploying from CI. The token value is only returned when the token is created, so it is empty after an import.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this API token was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Deployment the API token has access to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The API token's description.",
+				Optional:            true,
+			},
+			"end_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when the API token expires. Empty if the token doesn't expire.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiry_period_in_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days the API token is valid for. Leave unset for a token that doesn't expire. Changing it recreates the token.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The API token's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The API token's name.",
+				Required:            true,
+			},
+			"renewal_window_in_days": schema.Int64Attribute{
+				MarkdownDescription: "Recreate the API token when it expires within this many days, so it never silently expires. Requires `expiry_period_in_days`. The renewal happens on the first plan inside the window.",
+				Optional:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The API token's role on the Deployment, `DEPLOYMENT_ADMIN` or the ID of a custom Deployment role.",
+				Required:            true,
+			},
+			"short_token": schema.StringAttribute{
+				MarkdownDescription: "The first characters of the token, to recognize it in the Astro UI.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The API token value.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the API token was updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DeploymentApiTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+// ModifyPlan replaces the token once it expires within renewal_window_in_days. Terraform only
+// replaces a resource when a value changes, so the token is planned as unknown.
+func (r *DeploymentApiTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to renew on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DeploymentApiTokenResourceModel
+	var state DeploymentApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RenewalWindowInDays.IsNull() || plan.RenewalWindowInDays.IsUnknown() || state.EndAt.ValueString() == "" {
+		return
+	}
+	endAt, err := time.Parse(time.RFC3339, state.EndAt.ValueString())
+	if err != nil {
+		return
+	}
+	if time.Now().Before(endAt.AddDate(0, 0, -int(plan.RenewalWindowInDays.ValueInt64()))) {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"API Token Renewal",
+		fmt.Sprintf("API token %s expires at %s, within the renewal window of %d days. It will be replaced by a new token.", state.Name.ValueString(), state.EndAt.ValueString(), plan.RenewalWindowInDays.ValueInt64()),
+	)
+
+	plan.CreatedAt = types.StringUnknown()
+	plan.EndAt = types.StringUnknown()
+	plan.Id = types.StringUnknown()
+	plan.ShortToken = types.StringUnknown()
+	plan.Token = types.StringUnknown()
+	plan.UpdatedAt = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("token"))
+}
+
+func validateDeploymentApiTokenResourceModel(data DeploymentApiTokenResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.RenewalWindowInDays.IsNull() {
+		return diags
+	}
+	if data.ExpiryPeriodInDays.IsNull() {
+		diags.AddError("Validation Error", "renewal_window_in_days requires expiry_period_in_days")
+	} else if data.RenewalWindowInDays.ValueInt64() >= data.ExpiryPeriodInDays.ValueInt64() {
+		diags.AddError("Validation Error", "renewal_window_in_days must be shorter than expiry_period_in_days")
+	}
+	return diags
+}
+
+func loadDeploymentApiTokenResourceFromResponse(data *DeploymentApiTokenResourceModel, apiToken *api.ApiTokenResponse) {
+	idx := slices.IndexFunc(apiToken.Roles, func(role api.ApiTokenRole) bool { return role.EntityType == api.ApiTokenTypeDeployment })
+	if idx != -1 {
+		data.DeploymentId = types.StringValue(apiToken.Roles[idx].EntityId)
+		data.Role = types.StringValue(apiToken.Roles[idx].Role)
+	}
+
+	data.CreatedAt = types.StringValue(apiToken.CreatedAt)
+	data.Description = loadOptionalString(apiToken.Description)
+	data.EndAt = types.StringValue(apiToken.EndAt)
+	data.ExpiryPeriodInDays = loadOptionalInt64(apiToken.ExpiryPeriodInDays)
+	data.Id = types.StringValue(apiToken.Id)
+	data.Name = types.StringValue(apiToken.Name)
+	data.ShortToken = types.StringValue(apiToken.ShortToken)
+	data.UpdatedAt = types.StringValue(apiToken.UpdatedAt)
+	//Use state value unless the token was just created since it can't be retrieved
+	if apiToken.Token != "" {
+		data.Token = types.StringValue(apiToken.Token)
+	}
+}
+
+func (r *DeploymentApiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(validateDeploymentApiTokenResourceModel(data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createRequest := &api.ApiTokenCreateRequest{
+		Description:             data.Description.ValueString(),
+		EntityId:                data.DeploymentId.ValueString(),
+		Name:                    data.Name.ValueString(),
+		Role:                    data.Role.ValueString(),
+		TokenExpiryPeriodInDays: int(data.ExpiryPeriodInDays.ValueInt64()),
+		Type:                    api.ApiTokenTypeDeployment,
+	}
+
+	apiToken, err := api.CreateApiToken(r.token, r.organizationId, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API token, got error: %s", err))
+		return
+	}
+
+	loadDeploymentApiTokenResourceFromResponse(&data, apiToken)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentApiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeploymentApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiToken, err := api.GetApiToken(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token, got error: %s", err))
+		return
+	}
+
+	loadDeploymentApiTokenResourceFromResponse(&data, apiToken)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentApiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeploymentApiTokenResourceModel
+	var state DeploymentApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(validateDeploymentApiTokenResourceModel(data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateRequest := &api.ApiTokenUpdateRequest{
+		Description: data.Description.ValueString(),
+		Name:        data.Name.ValueString(),
+	}
+
+	apiToken, err := api.UpdateApiToken(r.token, r.organizationId, data.Id.ValueString(), updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update API token, got error: %s", err))
+		return
+	}
+
+	if !data.Role.Equal(state.Role) {
+		roleRequest := &api.ApiTokenRole{
+			EntityId:   data.DeploymentId.ValueString(),
+			EntityType: api.ApiTokenTypeDeployment,
+			Role:       data.Role.ValueString(),
+		}
+		err = api.UpdateApiTokenRole(r.token, r.organizationId, data.Id.ValueString(), roleRequest)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update API token role, got error: %s", err))
+			return
+		}
+
+		apiToken, err = api.GetApiToken(r.token, r.organizationId, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token, got error: %s", err))
+			return
+		}
+	}
+
+	loadDeploymentApiTokenResourceFromResponse(&data, apiToken)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentApiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeploymentApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteApiToken(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API token, got error: %s", err))
+		return
+	}
+}
+
+func (r *DeploymentApiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentApiTokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentApiTokenResourceConfig("TestDeploymentApiToken"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_api_token.test", "name", "TestDeploymentApiToken"),
+					resource.TestCheckResourceAttr("astronomer_deployment_api_token.test", "role", "DEPLOYMENT_ADMIN"),
+					resource.TestCheckResourceAttr("astronomer_deployment_api_token.test", "expiry_period_in_days", "30"),
+					resource.TestCheckResourceAttrSet("astronomer_deployment_api_token.test", "token"),
+					resource.TestCheckResourceAttrSet("astronomer_deployment_api_token.test", "end_at"),
+				),
+			},
+			{
+				ResourceName:            "astronomer_deployment_api_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "renewal_window_in_days", "updated_at"},
+			},
+			{
+				Config: testAccDeploymentApiTokenResourceConfig("TestDeploymentApiTokenUpdate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_api_token.test", "name", "TestDeploymentApiTokenUpdate"),
+					resource.TestCheckResourceAttrSet("astronomer_deployment_api_token.test", "token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentApiTokenResourceConfig(name string) string {
+	return testAccDeploymentConfig("TestApiTokenDeployment", "") + fmt.Sprintf(`
+resource "astronomer_deployment_api_token" "test" {
+	deployment_id = astronomer_deployment.test.id
+	name = %[1]q
+	role = "DEPLOYMENT_ADMIN"
+	expiry_period_in_days = 30
+	renewal_window_in_days = 7
+}
+`, name)
+}
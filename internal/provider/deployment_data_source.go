@@ -22,14 +22,16 @@ type DeploymentDataSource struct {
 }
 
 type DeploymentDataSourceModel struct {
-	AirflowVersion types.String `tfsdk:"airflow_version"`
-	CloudProvider  types.String `tfsdk:"cloud_provider"`
-	ClusterId      types.String `tfsdk:"cluster_id"`
-	ClusterName    types.String `tfsdk:"cluster_name"`
-	Id             types.String `tfsdk:"id"`
-	IsCicdEnforced types.Bool   `tfsdk:"is_cicd_enforced"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
+	AirflowVersion types.String              `tfsdk:"airflow_version"`
+	CloudProvider  types.String              `tfsdk:"cloud_provider"`
+	ClusterId      types.String              `tfsdk:"cluster_id"`
+	ClusterName    types.String              `tfsdk:"cluster_name"`
+	CreatedBy      *BasicSubjectProfileModel `tfsdk:"created_by"`
+	Id             types.String              `tfsdk:"id"`
+	IsCicdEnforced types.Bool                `tfsdk:"is_cicd_enforced"`
+	Name           types.String              `tfsdk:"name"`
+	Description    types.String              `tfsdk:"description"`
+	UpdatedBy      *BasicSubjectProfileModel `tfsdk:"updated_by"`
 }
 
 func (d *DeploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -57,6 +59,7 @@ func (d *DeploymentDataSource) Schema(ctx context.Context, req datasource.Schema
 				MarkdownDescription: "Cluster Name",
 				Computed:            true,
 			},
+			"created_by": basicSubjectProfileDataSourceAttribute("Who created this Deployment."),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The Deployment's Identifier",
 				Required:            true,
@@ -73,6 +76,7 @@ func (d *DeploymentDataSource) Schema(ctx context.Context, req datasource.Schema
 				MarkdownDescription: "The Deployment's description.",
 				Computed:            true,
 			},
+			"updated_by": basicSubjectProfileDataSourceAttribute("Who last updated this Deployment."),
 		},
 	}
 }
@@ -118,10 +122,12 @@ func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	data.CloudProvider = types.StringValue(decoded.CloudProvider)
 	data.ClusterId = types.StringValue(decoded.ClusterId)
 	data.ClusterName = types.StringValue(decoded.ClusterName)
+	data.CreatedBy = loadBasicSubjectProfileFromUser(decoded.CreatedBy)
 	data.Description = types.StringValue(decoded.Description)
 	data.Id = types.StringValue(decoded.Id)
 	data.IsCicdEnforced = types.BoolValue(decoded.IsCicdEnforced)
 	data.Name = types.StringValue(decoded.Name)
+	data.UpdatedBy = loadBasicSubjectProfileFromUser(decoded.UpdatedBy)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
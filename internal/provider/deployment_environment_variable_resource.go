@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &DeploymentEnvironmentVariableResource{}
+var _ resource.ResourceWithImportState = &DeploymentEnvironmentVariableResource{}
+var _ resource.ResourceWithValidateConfig = &DeploymentEnvironmentVariableResource{}
+
+func NewDeploymentEnvironmentVariableResource() resource.Resource {
+	return &DeploymentEnvironmentVariableResource{}
+}
+
+type DeploymentEnvironmentVariableResource struct {
+	token          string
+	organizationId string
+}
+
+type DeploymentEnvironmentVariableResourceModel struct {
+	DeploymentId   types.String `tfsdk:"deployment_id"`
+	Id             types.String `tfsdk:"id"`
+	IsSecret       types.Bool   `tfsdk:"is_secret"`
+	Key            types.String `tfsdk:"key"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	Value          types.String `tfsdk:"value"`
+	ValueWo        types.String `tfsdk:"value_wo"`
+	ValueWoVersion types.Int64  `tfsdk:"value_wo_version"`
+}
+
+func (r *DeploymentEnvironmentVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_environment_variable"
+}
+
+func (r *DeploymentEnvironmentVariableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A single environment variable on a Deployment. The variable is merged into the Deployment's existing environment variables, so variables can be owned by different Terraform configurations. Variables with other keys can still be set in `environment_variables` on `astronomer_deployment`, but don't declare the same key in both.",
+
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Deployment the environment variable belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The environment variable's identifier, in the form `deployment_id/key`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_secret": schema.BoolAttribute{
+				MarkdownDescription: "Whether the environment variable is a secret. Secret values can't be read back from the API, so changes made outside of Terraform are not detected.",
+				Required:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The environment variable key, used to call the value in code.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the environment variable was updated.",
+				Computed:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of an environment variable that isn't a secret. Exactly one of `value` or `value_wo` is required, secrets must use `value_wo`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value_wo")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				MarkdownDescription: "The value of a secret environment variable, which is never stored in the Terraform state. Changes aren't detected, bump `value_wo_version` to send a new value. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this to send `value_wo` to the API again, e.g. after rotating the secret.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
+			},
+		},
+	}
+}
+
+func (r *DeploymentEnvironmentVariableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DeploymentEnvironmentVariableResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.IsSecret.IsUnknown() {
+		return
+	}
+
+	if !data.ValueWo.IsNull() && !data.IsSecret.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("value_wo"), "Validation Error", "value_wo can only be used by secrets, set value instead.")
+	}
+	if !data.Value.IsNull() && data.IsSecret.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Validation Error", "Secret environment variables must set value_wo instead of value, which is stored in the Terraform state.")
+	}
+}
+
+func (r *DeploymentEnvironmentVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+func findEnvironmentVariable(deployment *api.DeploymentResponse, key string) int {
+	return slices.IndexFunc(deployment.EnvironmentVariables, func(envVar api.EnvironmentVariableResponse) bool { return envVar.Key == key })
+}
+
+// mergeEnvironmentVariable applies change to the Deployment's environment variables, leaving
+// every other setting and variable as it is.
+func (r *DeploymentEnvironmentVariableResource) mergeEnvironmentVariable(deploymentId string, change func([]api.EnvironmentVariableRequest) []api.EnvironmentVariableRequest, applied func([]api.EnvironmentVariableResponse) bool) (*api.DeploymentResponse, error) {
+	return mergeDeployment(r.token, r.organizationId, deploymentId, func(updateRequest *api.DeploymentUpdateRequest) {
+		updateRequest.EnvironmentVariables = change(updateRequest.EnvironmentVariables)
+	}, func(deployment *api.DeploymentResponse) bool {
+		return applied(deployment.EnvironmentVariables)
+	})
+}
+
+// putEnvironmentVariable writes the planned variable. The write-only value_wo is null in the plan,
+// so it's taken from the configuration. Secret values aren't returned by the API, so only their
+// presence is checked.
+func (r *DeploymentEnvironmentVariableResource) putEnvironmentVariable(data DeploymentEnvironmentVariableResourceModel, config DeploymentEnvironmentVariableResourceModel) (*api.DeploymentResponse, error) {
+	envVar := api.EnvironmentVariableRequest{
+		IsSecret: data.IsSecret.ValueBool(),
+		Key:      data.Key.ValueString(),
+		Value:    data.Value.ValueString(),
+	}
+	if !config.ValueWo.IsNull() {
+		envVar.Value = config.ValueWo.ValueString()
+	}
+
+	return r.mergeEnvironmentVariable(data.DeploymentId.ValueString(), func(envVars []api.EnvironmentVariableRequest) []api.EnvironmentVariableRequest {
+		idx := slices.IndexFunc(envVars, func(value api.EnvironmentVariableRequest) bool { return value.Key == envVar.Key })
+		if idx == -1 {
+			return append(envVars, envVar)
+		}
+		envVars[idx] = envVar
+		return envVars
+	}, func(envVars []api.EnvironmentVariableResponse) bool {
+		idx := slices.IndexFunc(envVars, func(value api.EnvironmentVariableResponse) bool { return value.Key == envVar.Key })
+		return idx != -1 &&
+			envVars[idx].IsSecret == envVar.IsSecret &&
+			(envVar.IsSecret || envVars[idx].Value == envVar.Value)
+	})
+}
+
+func loadDeploymentEnvironmentVariableFromResponse(data *DeploymentEnvironmentVariableResourceModel, envVar api.EnvironmentVariableResponse) {
+	data.Id = types.StringValue(data.DeploymentId.ValueString() + "/" + envVar.Key)
+	data.IsSecret = types.BoolValue(envVar.IsSecret)
+	data.Key = types.StringValue(envVar.Key)
+	data.UpdatedAt = types.StringValue(envVar.UpdatedAt)
+	//Use state value if secret since it can't be retrieved
+	if !envVar.IsSecret {
+		data.Value = types.StringValue(envVar.Value)
+	}
+}
+
+func (r *DeploymentEnvironmentVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentEnvironmentVariableResourceModel
+	var config DeploymentEnvironmentVariableResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.putEnvironmentVariable(data, config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create environment variable, got error: %s", err))
+		return
+	}
+
+	idx := findEnvironmentVariable(deployment, data.Key.ValueString())
+	if idx == -1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Environment variable %s was not found on Deployment %s after creation", data.Key.ValueString(), data.DeploymentId.ValueString()))
+		return
+	}
+	loadDeploymentEnvironmentVariableFromResponse(&data, deployment.EnvironmentVariables[idx])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentEnvironmentVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeploymentEnvironmentVariableResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := api.GetDeployment(r.token, r.organizationId, data.DeploymentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment variable, got error: %s", err))
+		return
+	}
+
+	idx := findEnvironmentVariable(deployment, data.Key.ValueString())
+	if idx == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	loadDeploymentEnvironmentVariableFromResponse(&data, deployment.EnvironmentVariables[idx])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentEnvironmentVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeploymentEnvironmentVariableResourceModel
+	var config DeploymentEnvironmentVariableResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.putEnvironmentVariable(data, config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update environment variable, got error: %s", err))
+		return
+	}
+
+	idx := findEnvironmentVariable(deployment, data.Key.ValueString())
+	if idx == -1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Environment variable %s was not found on Deployment %s after update", data.Key.ValueString(), data.DeploymentId.ValueString()))
+		return
+	}
+	loadDeploymentEnvironmentVariableFromResponse(&data, deployment.EnvironmentVariables[idx])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentEnvironmentVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeploymentEnvironmentVariableResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.mergeEnvironmentVariable(data.DeploymentId.ValueString(), func(envVars []api.EnvironmentVariableRequest) []api.EnvironmentVariableRequest {
+		return slices.DeleteFunc(envVars, func(value api.EnvironmentVariableRequest) bool { return value.Key == data.Key.ValueString() })
+	}, func(envVars []api.EnvironmentVariableResponse) bool {
+		return !slices.ContainsFunc(envVars, func(value api.EnvironmentVariableResponse) bool { return value.Key == data.Key.ValueString() })
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete environment variable, got error: %s", err))
+		return
+	}
+}
+
+func (r *DeploymentEnvironmentVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	deploymentId, key, found := strings.Cut(req.ID, "/")
+	if !found || deploymentId == "" || key == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: deployment_id/key. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
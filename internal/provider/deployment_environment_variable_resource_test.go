@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDeploymentEnvironmentVariableResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentEnvironmentVariableResourceConfig("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_environment_variable.test", "key", "TF_ACC_VARIABLE"),
+					resource.TestCheckResourceAttr("astronomer_deployment_environment_variable.test", "value", "one"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_DEPLOYMENT_VARIABLE.value", "deployment"),
+				),
+			},
+			{
+				ResourceName:      "astronomer_deployment_environment_variable.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentEnvironmentVariableResourceConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_environment_variable.test", "value", "two"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccDeploymentEnvironmentVariableResourceMultiple checks that variable resources writing the
+// same Deployment don't drop each other's variables.
+func TestAccDeploymentEnvironmentVariableResourceMultiple(t *testing.T) {
+	multipleConfig := func(value string) string {
+		return testAccDeploymentEnvironmentVariableResourceConfig(value) + `
+resource "astronomer_deployment_environment_variable" "other" {
+	deployment_id = astronomer_deployment.test.id
+	key = "TF_ACC_OTHER_VARIABLE"
+	value = "other"
+	is_secret = false
+}
+`
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: multipleConfig("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_environment_variable.test", "value", "one"),
+					resource.TestCheckResourceAttr("astronomer_deployment_environment_variable.other", "value", "other"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.%", "1"),
+				),
+			},
+			{
+				Config: multipleConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_environment_variable.test", "value", "two"),
+					resource.TestCheckResourceAttr("astronomer_deployment_environment_variable.other", "value", "other"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_DEPLOYMENT_VARIABLE.value", "deployment"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeploymentEnvironmentVariableResourceWriteOnly(t *testing.T) {
+	writeOnlyConfig := func(valueVersion int) string {
+		return testAccDeploymentEnvironmentVariableResourceConfig("one") + fmt.Sprintf(`
+resource "astronomer_deployment_environment_variable" "secret" {
+	deployment_id = astronomer_deployment.test.id
+	key = "TF_ACC_SECRET"
+	value_wo = "SECRET_VALUE_%[1]d"
+	value_wo_version = %[1]d
+	is_secret = true
+}
+`, valueVersion)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: writeOnlyConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("astronomer_deployment_environment_variable.secret", "value"),
+					resource.TestCheckNoResourceAttr("astronomer_deployment_environment_variable.secret", "value_wo"),
+					resource.TestCheckResourceAttr("astronomer_deployment_environment_variable.secret", "value_wo_version", "1"),
+				),
+			},
+			{
+				Config: writeOnlyConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("astronomer_deployment_environment_variable.secret", "value_wo"),
+					resource.TestCheckResourceAttr("astronomer_deployment_environment_variable.secret", "value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeploymentEnvironmentVariableResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(testAccDeploymentEnvironmentVariableResourceConfig("one"), "value = \"one\"\n\tis_secret = false", "value = \"one\"\n\tis_secret = true", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Secret environment variables must set value_wo instead of value`),
+			},
+		},
+	})
+}
+
+func testAccDeploymentEnvironmentVariableResourceConfig(value string) string {
+	deploymentAttributes := `	environment_variables = {
+		TF_ACC_DEPLOYMENT_VARIABLE = { is_secret = false, value = "deployment" }
+	}
+`
+	return testAccDeploymentConfig("TestEnvironmentVariableDeployment", deploymentAttributes) + fmt.Sprintf(`
+resource "astronomer_deployment_environment_variable" "test" {
+	deployment_id = astronomer_deployment.test.id
+	key = "TF_ACC_VARIABLE"
+	value = %[1]q
+	is_secret = false
+}
+`, value)
+}
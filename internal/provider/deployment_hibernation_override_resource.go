@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &DeploymentHibernationOverrideResource{}
+var _ resource.ResourceWithImportState = &DeploymentHibernationOverrideResource{}
+var _ resource.ResourceWithValidateConfig = &DeploymentHibernationOverrideResource{}
+
+func NewDeploymentHibernationOverrideResource() resource.Resource {
+	return &DeploymentHibernationOverrideResource{}
+}
+
+type DeploymentHibernationOverrideResource struct {
+	token          string
+	organizationId string
+}
+
+type DeploymentHibernationOverrideResourceModel struct {
+	DeploymentId  types.String `tfsdk:"deployment_id"`
+	Id            types.String `tfsdk:"id"`
+	IsActive      types.Bool   `tfsdk:"is_active"`
+	IsHibernating types.Bool   `tfsdk:"is_hibernating"`
+	OverrideUntil types.String `tfsdk:"override_until"`
+}
+
+func (r *DeploymentHibernationOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_hibernation_override"
+}
+
+func (r *DeploymentHibernationOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Overrides the hibernation schedules of a development Deployment, keeping it hibernating or awake until a given time. Destroying the resource removes the override and the Deployment goes back to its schedules.",
+
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Deployment to override.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The override's identifier, the same as `deployment_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the override is currently in effect. It's `false` once `override_until` has passed, the resource then stays in state until `override_until` is changed or the resource is destroyed.",
+				Computed:            true,
+			},
+			"is_hibernating": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Deployment is forced to hibernate (`true`) or to stay awake (`false`). Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"override_until": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp of when the override ends, e.g. `2024-12-31T18:00:00Z`. Leave unset to keep the override until the resource is destroyed.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *DeploymentHibernationOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+// putOverride sets the override under the Deployment lock, so an astronomer_deployment update in
+// the same apply can't write back a stale override.
+func (r *DeploymentHibernationOverrideResource) putOverride(data DeploymentHibernationOverrideResourceModel) (*api.DeploymentHibernationOverride, error) {
+	unlock := lockDeployment(data.DeploymentId.ValueString())
+	defer unlock()
+
+	overrideRequest := &api.DeploymentHibernationOverride{
+		IsHibernating: data.IsHibernating.ValueBool(),
+		OverrideUntil: data.OverrideUntil.ValueString(),
+	}
+
+	return api.UpdateDeploymentHibernationOverride(r.token, r.organizationId, data.DeploymentId.ValueString(), overrideRequest)
+}
+
+// overrideExpired reports whether override_until has passed, after which the API drops the override.
+func overrideExpired(overrideUntil string) bool {
+	until, err := time.Parse(time.RFC3339, overrideUntil)
+	return err == nil && until.Before(time.Now())
+}
+
+func parseOverrideUntil(overrideUntil string) (time.Time, error) {
+	until, err := time.Parse(time.RFC3339, overrideUntil)
+	if err != nil {
+		return until, fmt.Errorf("override_until must be an RFC 3339 timestamp, got %q", overrideUntil)
+	}
+	return until, nil
+}
+
+func validateOverrideUntil(data DeploymentHibernationOverrideResourceModel) error {
+	if data.OverrideUntil.ValueString() == "" {
+		return nil
+	}
+	overrideUntil, err := parseOverrideUntil(data.OverrideUntil.ValueString())
+	if err != nil {
+		return err
+	}
+	if overrideUntil.Before(time.Now()) {
+		return fmt.Errorf("override_until must be in the future, got %q", data.OverrideUntil.ValueString())
+	}
+	return nil
+}
+
+func loadDeploymentHibernationOverrideFromResponse(data *DeploymentHibernationOverrideResourceModel, override *api.DeploymentHibernationOverride) {
+	data.Id = data.DeploymentId
+	data.IsActive = types.BoolValue(override.IsActive)
+	data.IsHibernating = types.BoolValue(override.IsHibernating)
+	if override.OverrideUntil == "" {
+		data.OverrideUntil = types.StringNull()
+		return
+	}
+	// The API may format the timestamp differently, keep ours when it's the same instant
+	current, err := time.Parse(time.RFC3339, data.OverrideUntil.ValueString())
+	returned, returnedErr := time.Parse(time.RFC3339, override.OverrideUntil)
+	if err != nil || returnedErr != nil || !current.Equal(returned) {
+		data.OverrideUntil = types.StringValue(override.OverrideUntil)
+	}
+}
+
+// ValidateConfig only checks the format of override_until. Whether it's in the future is checked
+// when the override is set, an override that has ended stays in the configuration.
+func (r *DeploymentHibernationOverrideResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var overrideUntil types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("override_until"), &overrideUntil)...)
+
+	if resp.Diagnostics.HasError() || overrideUntil.IsNull() || overrideUntil.IsUnknown() {
+		return
+	}
+
+	if _, err := parseOverrideUntil(overrideUntil.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("override_until"), "Validation Error", err.Error())
+	}
+}
+
+func (r *DeploymentHibernationOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentHibernationOverrideResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if err := validateOverrideUntil(data); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	override, err := r.putOverride(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create hibernation override, got error: %s", err))
+		return
+	}
+	loadDeploymentHibernationOverrideFromResponse(&data, override)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentHibernationOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeploymentHibernationOverrideResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := api.GetDeployment(r.token, r.organizationId, data.DeploymentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hibernation override, got error: %s", err))
+		return
+	}
+
+	// The API drops the override once override_until has passed. The expired override is kept, as
+	// inactive, so the configuration isn't planned for creation again with a timestamp in the past.
+	if deployment.ScalingSpec == nil || deployment.ScalingSpec.HibernationSpec == nil || deployment.ScalingSpec.HibernationSpec.Override == nil {
+		if !overrideExpired(data.OverrideUntil.ValueString()) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.IsActive = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	loadDeploymentHibernationOverrideFromResponse(&data, deployment.ScalingSpec.HibernationSpec.Override)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentHibernationOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeploymentHibernationOverrideResourceModel
+	var state DeploymentHibernationOverrideResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An expired override can't be changed, only replaced by one with a new override_until
+	if data.OverrideUntil.Equal(state.OverrideUntil) && overrideExpired(state.OverrideUntil.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("override_until"), "Validation Error", fmt.Sprintf("The override ended at %s, set a new override_until to change it.", state.OverrideUntil.ValueString()))
+	} else if err := validateOverrideUntil(data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("override_until"), "Validation Error", err.Error())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	override, err := r.putOverride(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update hibernation override, got error: %s", err))
+		return
+	}
+	loadDeploymentHibernationOverrideFromResponse(&data, override)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentHibernationOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeploymentHibernationOverrideResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := lockDeployment(data.DeploymentId.ValueString())
+	defer unlock()
+
+	err := api.DeleteDeploymentHibernationOverride(r.token, r.organizationId, data.DeploymentId.ValueString())
+	// An expired override may already be gone
+	if err != nil && !overrideExpired(data.OverrideUntil.ValueString()) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete hibernation override, got error: %s", err))
+		return
+	}
+}
+
+func (r *DeploymentHibernationOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), req.ID)...)
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentHibernationOverrideResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentHibernationOverrideResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "is_development_mode", "true"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "scaling_spec.hibernation_spec.schedules.#", "1"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "scaling_spec.hibernation_spec.schedules.0.is_enabled", "true"),
+					resource.TestCheckResourceAttr("astronomer_deployment_hibernation_override.test", "is_hibernating", "true"),
+					resource.TestCheckResourceAttr("astronomer_deployment_hibernation_override.test", "override_until", "2099-01-01T00:00:00Z"),
+				),
+			},
+			{
+				ResourceName:      "astronomer_deployment_hibernation_override.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentHibernationOverrideResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_hibernation_override.test", "is_hibernating", "false"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "scaling_spec.hibernation_spec.schedules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeploymentHibernationOverrideResourceExpired(t *testing.T) {
+	overrideUntil := time.Now().Add(2 * time.Minute).UTC().Format(time.RFC3339)
+	config := strings.Replace(testAccDeploymentHibernationOverrideResourceConfig(true), "2099-01-01T00:00:00Z", overrideUntil, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_hibernation_override.test", "is_active", "true"),
+				),
+			},
+			{
+				// Once override_until has passed, the override stays in state without changes
+				PreConfig: func() { time.Sleep(3 * time.Minute) },
+				Config:    config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_hibernation_override.test", "is_active", "false"),
+					resource.TestCheckResourceAttr("astronomer_deployment_hibernation_override.test", "override_until", overrideUntil),
+				),
+			},
+			{
+				// Updating the Deployment doesn't send the ended override back
+				Config: strings.Replace(config, `description = "A Standard Deployment"`, `description = "An updated Deployment"`, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "description", "An updated Deployment"),
+					resource.TestCheckResourceAttr("astronomer_deployment_hibernation_override.test", "is_active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeploymentHibernationOverrideResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %q
+}
+
+resource "astronomer_deployment_hibernation_override" "test" {
+	deployment_id = "deployment"
+	override_until = "2099-01-01 00:00"
+}
+`, os.Getenv("ORGANIZATION_ID")),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`override_until must be an RFC 3339 timestamp`),
+			},
+		},
+	})
+}
+
+func testAccDeploymentHibernationOverrideResourceConfig(isHibernating bool) string {
+	deploymentAttributes := `	is_development_mode = true
+	scaling_spec = {
+		hibernation_spec = {
+			schedules = [
+				{
+				description:       "Nights",
+				hibernate_at_cron: "0 20 * * *",
+				wake_at_cron:      "0 8 * * *",
+				},
+			]
+		}
+	}
+`
+	return testAccDeploymentConfig("TestHibernationDeployment", deploymentAttributes) + fmt.Sprintf(`
+resource "astronomer_deployment_hibernation_override" "test" {
+	deployment_id = astronomer_deployment.test.id
+	is_hibernating = %[1]t
+	override_until = "2099-01-01T00:00:00Z"
+}
+`, isHibernating)
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ datasource.DataSource = &DeploymentOptionsDataSource{}
+
+func NewDeploymentOptionsDataSource() datasource.DataSource {
+	return &DeploymentOptionsDataSource{}
+}
+
+type DeploymentOptionsDataSource struct {
+	token          string
+	organizationId string
+}
+
+type DeploymentOptionsDataSourceModel struct {
+	AstroMachines     []types.String              `tfsdk:"astro_machines"`
+	CloudProvider     types.String                `tfsdk:"cloud_provider"`
+	DeploymentType    types.String                `tfsdk:"deployment_type"`
+	Executor          types.String                `tfsdk:"executor"`
+	Executors         []types.String              `tfsdk:"executors"`
+	ResourceQuotas    *ResourceQuotaOptionsModel  `tfsdk:"resource_quotas"`
+	RuntimeReleases   []RuntimeReleaseOptionModel `tfsdk:"runtime_releases"`
+	RuntimeVersions   []types.String              `tfsdk:"runtime_versions"`
+	SchedulerMachines []MachineOptionModel        `tfsdk:"scheduler_machines"`
+	SchedulerSizes    []types.String              `tfsdk:"scheduler_sizes"`
+	WorkerMachines    []MachineOptionModel        `tfsdk:"worker_machines"`
+	WorkerQueues      *WorkerQueueOptionsModel    `tfsdk:"worker_queues"`
+}
+
+type ResourceQuotaOptionsModel struct {
+	DefaultPodSize *ResourceOptionModel `tfsdk:"default_pod_size"`
+	ResourceQuota  *ResourceOptionModel `tfsdk:"resource_quota"`
+}
+
+type RuntimeReleaseOptionModel struct {
+	AirflowVersion types.String `tfsdk:"airflow_version"`
+	Channel        types.String `tfsdk:"channel"`
+	ReleaseDate    types.String `tfsdk:"release_date"`
+	Version        types.String `tfsdk:"version"`
+}
+
+type MachineOptionModel struct {
+	Concurrency *RangeModel  `tfsdk:"concurrency"`
+	Cpu         types.String `tfsdk:"cpu"`
+	Memory      types.String `tfsdk:"memory"`
+	Name        types.String `tfsdk:"name"`
+}
+
+type WorkerQueueOptionsModel struct {
+	MaxWorkers        *RangeModel `tfsdk:"max_workers"`
+	MinWorkers        *RangeModel `tfsdk:"min_workers"`
+	WorkerConcurrency *RangeModel `tfsdk:"worker_concurrency"`
+}
+
+func (d *DeploymentOptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_options"
+}
+
+func machineOptionsDataSourceAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"concurrency": rangeDataSourceAttribute("The allowed worker concurrency of the machine. Only set for worker machines."),
+				"cpu": schema.StringAttribute{
+					MarkdownDescription: "The machine's CPU quantity.",
+					Computed:            true,
+				},
+				"memory": schema.StringAttribute{
+					MarkdownDescription: "The machine's memory quantity.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The machine's name.",
+					Computed:            true,
+				},
+			},
+		},
+		MarkdownDescription: description,
+		Computed:            true,
+	}
+}
+
+func (d *DeploymentOptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The values the API accepts when creating or updating a Deployment, to validate module inputs before apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"astro_machines": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of `worker_machines`, as used in `astro_machine` on worker queues.",
+				Computed:            true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "Only return options for this cloud provider: `AWS`, `AZURE` or `GCP`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.CloudProviderAws, api.CloudProviderAzure, api.CloudProviderGcp),
+				},
+			},
+			"deployment_type": schema.StringAttribute{
+				MarkdownDescription: "Only return options for this type of Deployment: `DEDICATED`, `HYBRID` or `STANDARD`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.DeploymentTypeDedicated, api.DeploymentTypeHybrid, api.DeploymentTypeStandard),
+				},
+			},
+			"executor": schema.StringAttribute{
+				MarkdownDescription: "Only return options for this executor: `CELERY` or `KUBERNETES`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.DeploymentExecutorCelery, api.DeploymentExecutorKubernetes),
+				},
+			},
+			"executors": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The available executors.",
+				Computed:            true,
+			},
+			"resource_quotas": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"default_pod_size": resourceOptionDataSourceAttribute("The allowed `default_task_pod_cpu` and `default_task_pod_memory`."),
+					"resource_quota":   resourceOptionDataSourceAttribute("The allowed `resource_quota_cpu` and `resource_quota_memory`."),
+				},
+				MarkdownDescription: "The allowed resource quotas.",
+				Computed:            true,
+			},
+			"runtime_releases": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"airflow_version": schema.StringAttribute{
+							MarkdownDescription: "The Airflow version the release is based on.",
+							Computed:            true,
+						},
+						"channel": schema.StringAttribute{
+							MarkdownDescription: "The release channel.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "When the release was published.",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "The Astro Runtime version.",
+							Computed:            true,
+						},
+					},
+				},
+				MarkdownDescription: "The Astro Runtime releases that can be deployed.",
+				Computed:            true,
+			},
+			"runtime_versions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The versions of `runtime_releases`, as used in `astro_runtime_version`.",
+				Computed:            true,
+			},
+			"scheduler_machines": machineOptionsDataSourceAttribute("The available scheduler machines."),
+			"scheduler_sizes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of `scheduler_machines`, as used in `scheduler_size`.",
+				Computed:            true,
+			},
+			"worker_machines": machineOptionsDataSourceAttribute("The available worker machines."),
+			"worker_queues": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"max_workers":        rangeDataSourceAttribute("The allowed `max_worker_count`."),
+					"min_workers":        rangeDataSourceAttribute("The allowed `min_worker_count`."),
+					"worker_concurrency": rangeDataSourceAttribute("The allowed `worker_concurrency`."),
+				},
+				MarkdownDescription: "The allowed worker queue settings.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DeploymentOptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderDataSourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderDataSourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.token = provider.Token
+	d.organizationId = provider.OrganizationId
+}
+
+func loadMachineOptionsFromResponse(machines []api.MachineOption) ([]MachineOptionModel, []types.String) {
+	var models []MachineOptionModel = []MachineOptionModel{}
+	var names []types.String = []types.String{}
+	for _, machine := range machines {
+		model := MachineOptionModel{
+			Cpu:    types.StringValue(machine.Spec.Cpu),
+			Memory: types.StringValue(machine.Spec.Memory),
+			Name:   types.StringValue(machine.Name),
+		}
+		if machine.Spec.Concurrency != nil {
+			model.Concurrency = loadRangeFromResponse(*machine.Spec.Concurrency)
+		}
+		models = append(models, model)
+		names = append(names, types.StringValue(machine.Name))
+	}
+	return models, names
+}
+
+func (d *DeploymentOptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentOptionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optionsRequest := &api.DeploymentOptionsRequest{
+		CloudProvider:  data.CloudProvider.ValueString(),
+		DeploymentType: data.DeploymentType.ValueString(),
+		Executor:       data.Executor.ValueString(),
+	}
+
+	options, err := api.GetDeploymentOptions(d.token, d.organizationId, optionsRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("ERROR: %s", err.Error()))
+		return
+	}
+
+	data.Executors = createTFStringListFromStrings(options.Executors)
+	data.ResourceQuotas = &ResourceQuotaOptionsModel{
+		DefaultPodSize: loadResourceOptionFromResponse(options.ResourceQuotas.DefaultPodSize),
+		ResourceQuota:  loadResourceOptionFromResponse(options.ResourceQuotas.ResourceQuota),
+	}
+	data.SchedulerMachines, data.SchedulerSizes = loadMachineOptionsFromResponse(options.SchedulerMachines)
+	data.WorkerMachines, data.AstroMachines = loadMachineOptionsFromResponse(options.WorkerMachines)
+	data.WorkerQueues = &WorkerQueueOptionsModel{
+		MaxWorkers:        loadRangeFromResponse(options.WorkerQueues.MaxWorkers),
+		MinWorkers:        loadRangeFromResponse(options.WorkerQueues.MinWorkers),
+		WorkerConcurrency: loadRangeFromResponse(options.WorkerQueues.WorkerConcurrency),
+	}
+
+	data.RuntimeReleases = []RuntimeReleaseOptionModel{}
+	data.RuntimeVersions = []types.String{}
+	for _, release := range options.RuntimeReleases {
+		data.RuntimeReleases = append(data.RuntimeReleases, RuntimeReleaseOptionModel{
+			AirflowVersion: types.StringValue(release.AirflowVersion),
+			Channel:        types.StringValue(release.Channel),
+			ReleaseDate:    types.StringValue(release.ReleaseDate),
+			Version:        types.StringValue(release.Version),
+		})
+		data.RuntimeVersions = append(data.RuntimeVersions, types.StringValue(release.Version))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
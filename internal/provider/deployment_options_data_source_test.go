@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDeploymentOptionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentOptionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.astronomer_deployment_options.test", "executors.0"),
+					resource.TestCheckResourceAttrSet("data.astronomer_deployment_options.test", "astro_machines.0"),
+					resource.TestCheckResourceAttrSet("data.astronomer_deployment_options.test", "scheduler_sizes.0"),
+					resource.TestCheckResourceAttrSet("data.astronomer_deployment_options.test", "runtime_versions.0"),
+					resource.TestCheckResourceAttrSet("data.astronomer_deployment_options.test", "resource_quotas.resource_quota.cpu.ceiling"),
+					resource.TestCheckResourceAttrSet("data.astronomer_deployment_options.test", "worker_queues.max_workers.ceiling"),
+				),
+			},
+		},
+	})
+}
+
+func testDeploymentOptionsDataSourceConfig() string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+data "astronomer_deployment_options" "test" {
+	cloud_provider = "AWS"
+	deployment_type = "STANDARD"
+}
+`, orgId)
+}
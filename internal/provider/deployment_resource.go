@@ -5,19 +5,26 @@ import (
 	"fmt"
 	"slices"
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	api "github.com/openglshaders/astronomer-api/v2"
 )
 
 var _ resource.Resource = &DeploymentResource{}
 var _ resource.ResourceWithImportState = &DeploymentResource{}
+var _ resource.ResourceWithValidateConfig = &DeploymentResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentResource{}
 
 func NewDeploymentResource() resource.Resource {
 	return &DeploymentResource{}
@@ -28,34 +35,52 @@ type DeploymentResource struct {
 	organizationId string
 }
 type EnvironmentVariableModel struct {
-	IsSecret types.Bool   `tfsdk:"is_secret"`
-	Key      types.String `tfsdk:"key"`
-	Value    types.String `tfsdk:"value"`
+	IsSecret       types.Bool   `tfsdk:"is_secret"`
+	Value          types.String `tfsdk:"value"`
+	ValueWo        types.String `tfsdk:"value_wo"`
+	ValueWoVersion types.Int64  `tfsdk:"value_wo_version"`
 }
 
 type DeploymentResourceModel struct {
-	AstroRuntimeVersion  types.String               `tfsdk:"astro_runtime_version"`
-	CloudProvider        types.String               `tfsdk:"cloud_provider"`
-	ClusterId            types.String               `tfsdk:"cluster_id"`
-	DefaultTaskPodCpu    types.String               `tfsdk:"default_task_pod_cpu"`
-	DefaultTaskPodMemory types.String               `tfsdk:"default_task_pod_memory"`
-	Description          types.String               `tfsdk:"description"`
-	EnvironmentVariables []EnvironmentVariableModel `tfsdk:"environment_variables"`
-	Executor             types.String               `tfsdk:"executor"`
-	Id                   types.String               `tfsdk:"id"`
-	IsCicdEnforced       types.Bool                 `tfsdk:"is_cicd_enforced"`
-	IsDagDeployEnabled   types.Bool                 `tfsdk:"is_dag_deploy_enabled"`
-	IsHighAvailability   types.Bool                 `tfsdk:"is_high_availability"`
-	Name                 types.String               `tfsdk:"name"`
-	Region               types.String               `tfsdk:"region"`
-	ResourceQuotaCpu     types.String               `tfsdk:"resource_quota_cpu"`
-	ResourceQuotaMemory  types.String               `tfsdk:"resource_quota_memory"`
-	TaskPodNodePoolId    types.String               `tfsdk:"task_pod_node_pool_id"`
-	SchedulerSize        types.String               `tfsdk:"scheduler_size"`
-	Type                 types.String               `tfsdk:"type"`
-	WorkerQueues         []WorkerQueueModel         `tfsdk:"worker_queues"`
-	WorkloadIdentity     types.String               `tfsdk:"workload_identity"`
-	WorkspaceId          types.String               `tfsdk:"workspace_id"`
+	AstroRuntimeVersion  types.String                        `tfsdk:"astro_runtime_version"`
+	CloudProvider        types.String                        `tfsdk:"cloud_provider"`
+	ClusterId            types.String                        `tfsdk:"cluster_id"`
+	DefaultTaskPodCpu    types.String                        `tfsdk:"default_task_pod_cpu"`
+	DefaultTaskPodMemory types.String                        `tfsdk:"default_task_pod_memory"`
+	Description          types.String                        `tfsdk:"description"`
+	EnvironmentVariables map[string]EnvironmentVariableModel `tfsdk:"environment_variables"`
+	Executor             types.String                        `tfsdk:"executor"`
+	Id                   types.String                        `tfsdk:"id"`
+	IsCicdEnforced       types.Bool                          `tfsdk:"is_cicd_enforced"`
+	IsDagDeployEnabled   types.Bool                          `tfsdk:"is_dag_deploy_enabled"`
+	IsDevelopmentMode    types.Bool                          `tfsdk:"is_development_mode"`
+	IsHighAvailability   types.Bool                          `tfsdk:"is_high_availability"`
+	Name                 types.String                        `tfsdk:"name"`
+	Region               types.String                        `tfsdk:"region"`
+	ResourceQuotaCpu     types.String                        `tfsdk:"resource_quota_cpu"`
+	ResourceQuotaMemory  types.String                        `tfsdk:"resource_quota_memory"`
+	ScalingSpec          *DeploymentScalingSpecModel         `tfsdk:"scaling_spec"`
+	TaskPodNodePoolId    types.String                        `tfsdk:"task_pod_node_pool_id"`
+	SchedulerSize        types.String                        `tfsdk:"scheduler_size"`
+	Type                 types.String                        `tfsdk:"type"`
+	WorkerQueues         map[string]WorkerQueueModel         `tfsdk:"worker_queues"`
+	WorkloadIdentity     types.String                        `tfsdk:"workload_identity"`
+	WorkspaceId          types.String                        `tfsdk:"workspace_id"`
+}
+
+type DeploymentScalingSpecModel struct {
+	HibernationSpec *DeploymentHibernationSpecModel `tfsdk:"hibernation_spec"`
+}
+
+type DeploymentHibernationSpecModel struct {
+	Schedules []DeploymentHibernationScheduleModel `tfsdk:"schedules"`
+}
+
+type DeploymentHibernationScheduleModel struct {
+	Description     types.String `tfsdk:"description"`
+	HibernateAtCron types.String `tfsdk:"hibernate_at_cron"`
+	IsEnabled       types.Bool   `tfsdk:"is_enabled"`
+	WakeAtCron      types.String `tfsdk:"wake_at_cron"`
 }
 
 type WorkerQueueModel struct {
@@ -64,7 +89,9 @@ type WorkerQueueModel struct {
 	IsDefault         types.Bool   `tfsdk:"is_default"`
 	MaxWorkerCount    types.Int64  `tfsdk:"max_worker_count"`
 	MinWorkerCount    types.Int64  `tfsdk:"min_worker_count"`
-	Name              types.String `tfsdk:"name"`
+	NodePoolId        types.String `tfsdk:"node_pool_id"`
+	PodCpu            types.String `tfsdk:"pod_cpu"`
+	PodMemory         types.String `tfsdk:"pod_memory"`
 	WorkerConcurrency types.Int64  `tfsdk:"worker_concurrency"`
 }
 
@@ -76,12 +103,15 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "An Astro Deployment is an Airflow environment that is powered by all core Airflow components.",
 
+		// Version 1 keys environment_variables by variable key, version 2 keys worker_queues by name
+		Version: 2,
+
 		Attributes: map[string]schema.Attribute{
 			"astro_runtime_version": schema.StringAttribute{
-				MarkdownDescription: "Deployment's Astro Runtime version.",
+				MarkdownDescription: "Deployment's Astro Runtime version. Upgrades are applied in place and wait for the Deployment to be healthy on the new version, downgrades replace the Deployment.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					runtimeVersionDowngradeRequiresReplace{},
 				},
 			},
 			"cloud_provider": schema.StringAttribute{
@@ -91,6 +121,9 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.CloudProviderAws, api.CloudProviderAzure, api.CloudProviderGcp),
+				},
 			},
 			"cluster_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the cluster where the Deployment will be created.",
@@ -102,39 +135,61 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"default_task_pod_cpu": schema.StringAttribute{
 				MarkdownDescription: "The default CPU resource usage for a worker Pod when running the Kubernetes executor or KubernetesPodOperator. Units are in number of CPU cores.",
 				Required:            true,
+				Validators: []validator.String{
+					cpuQuantityValidator{},
+				},
 			},
 			"default_task_pod_memory": schema.StringAttribute{
-				MarkdownDescription: "The default memory resource usage for a worker Pod when running the Kubernetes executor or KubernetesPodOperator. Units are in `Gi`. This value must always be twice the value of `DefaultTaskPodCpu`.",
+				MarkdownDescription: "The default memory resource usage for a worker Pod when running the Kubernetes executor or KubernetesPodOperator. Units are in `Gi`. This value must always be twice the value of `default_task_pod_cpu`.",
 				Required:            true,
+				Validators: []validator.String{
+					memoryQuantityValidator{},
+					memoryTwiceCpuValidator{cpuAttribute: "default_task_pod_cpu"},
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The Deployment's description.",
 				Optional:            true,
 			},
-			"environment_variables": schema.ListNestedAttribute{
+			"environment_variables": schema.MapNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"is_secret": schema.BoolAttribute{
 							Required:            true,
-							MarkdownDescription: "Whether the environment variable is a secret.",
-						},
-						"key": schema.StringAttribute{
-							Required:            true,
-							MarkdownDescription: "The environment variable key, used to call the value in code.",
+							MarkdownDescription: "Whether the environment variable is a secret. Secret values can't be read back from the API, so changes made outside of Terraform are not detected.",
 						},
 						"value": schema.StringAttribute{
 							Optional:            true,
 							Sensitive:           true,
-							MarkdownDescription: "The environment variable value.",
+							MarkdownDescription: "The value of an environment variable that isn't a secret. Secrets must use `value_wo`.",
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("value_wo")),
+							},
+						},
+						"value_wo": schema.StringAttribute{
+							Optional:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+							MarkdownDescription: "The value of a secret environment variable, which is never stored in the Terraform state. It's only sent when the variable is created or `value_wo_version` changes. Requires Terraform 1.11 or later.",
+						},
+						"value_wo_version": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Change this to send `value_wo` to the API again, e.g. after rotating the secret.",
+							Validators: []validator.Int64{
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("value_wo")),
+							},
 						},
 					},
 				},
-				MarkdownDescription: "List of environment variables to add to the Deployment.",
+				MarkdownDescription: "Environment variables of the Deployment, keyed by the variable key used to call the value in code. Variables created with `astronomer_deployment_environment_variable` are left out of this map.",
 				Optional:            true,
 			},
 			"executor": schema.StringAttribute{
-				MarkdownDescription: "The Deployment's executor type.",
+				MarkdownDescription: "The Deployment's executor type, `CELERY` or `KUBERNETES`.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.DeploymentExecutorCelery, api.DeploymentExecutorKubernetes),
+				},
 			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The Deployment's identifier.",
@@ -151,6 +206,21 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Whether the Deployment has DAG deploys enabled.",
 				Required:            true,
 			},
+			"is_development_mode": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Deployment is a development Deployment. Development Deployments can hibernate but can't be highly available. Not available for `HYBRID` Deployments. Turning it on recreates the Deployment. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.ValueBool() && req.PlanValue.ValueBool()
+						},
+						"Turning on development mode recreates the Deployment.",
+						"Turning on development mode recreates the Deployment.",
+					),
+				},
+			},
 			"is_high_availability": schema.BoolAttribute{
 				MarkdownDescription: "Whether the Deployment is configured for high availability. If `true`, multiple scheduler pods will be online.",
 				Required:            true,
@@ -166,28 +236,84 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"resource_quota_cpu": schema.StringAttribute{
 				MarkdownDescription: "The CPU quota for worker Pods when running the Kubernetes executor or KubernetesPodOperator. If current CPU usage across all workers exceeds the quota, no new worker Pods can be scheduled. Units are in number of CPU cores.",
 				Required:            true,
+				Validators: []validator.String{
+					cpuQuantityValidator{},
+				},
 			},
 			"resource_quota_memory": schema.StringAttribute{
-				MarkdownDescription: "The memory quota for worker Pods when running the Kubernetes executor or KubernetesPodOperator. If current memory usage across all workers exceeds the quota, no new worker Pods can be scheduled. Units are in `Gi`. This value must always be twice the value of `ResourceQuotaCpu`.",
+				MarkdownDescription: "The memory quota for worker Pods when running the Kubernetes executor or KubernetesPodOperator. If current memory usage across all workers exceeds the quota, no new worker Pods can be scheduled. Units are in `Gi`. This value must always be twice the value of `resource_quota_cpu`.",
 				Required:            true,
+				Validators: []validator.String{
+					memoryQuantityValidator{},
+					memoryTwiceCpuValidator{cpuAttribute: "resource_quota_cpu"},
+				},
+			},
+			"scaling_spec": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"hibernation_spec": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"schedules": schema.ListNestedAttribute{
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"description": schema.StringAttribute{
+											Optional:            true,
+											MarkdownDescription: "The schedule's description.",
+										},
+										"hibernate_at_cron": schema.StringAttribute{
+											Required:            true,
+											MarkdownDescription: "A cron expression for when the Deployment hibernates, e.g. `0 20 * * 1-5`.",
+										},
+										"is_enabled": schema.BoolAttribute{
+											Optional:            true,
+											Computed:            true,
+											Default:             booldefault.StaticBool(true),
+											MarkdownDescription: "Whether the schedule is active. Defaults to `true`.",
+										},
+										"wake_at_cron": schema.StringAttribute{
+											Required:            true,
+											MarkdownDescription: "A cron expression for when the Deployment wakes up, e.g. `0 8 * * 1-5`.",
+										},
+									},
+								},
+								MarkdownDescription: "The hibernate/wake schedules of the Deployment.",
+								Required:            true,
+							},
+						},
+						MarkdownDescription: "The Deployment's hibernation schedules.",
+						Required:            true,
+					},
+				},
+				MarkdownDescription: "The Deployment's scaling settings. Hibernation is only available for development Deployments. Use `astronomer_deployment_hibernation_override` to hibernate or wake the Deployment outside of its schedules.",
+				Optional:            true,
 			},
 			"scheduler_size": schema.StringAttribute{
-				MarkdownDescription: "The size of the scheduler pod.",
+				MarkdownDescription: "The size of the scheduler pod. One of `SMALL`, `MEDIUM`, `LARGE` or `EXTRA_LARGE`.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.SchedulerSizeSmall, api.SchedulerSizeMedium, api.SchedulerSizeLarge, api.SchedulerSizeExtraLarge),
+				},
 			},
 			"task_pod_node_pool_id": schema.StringAttribute{
 				MarkdownDescription: "The node pool ID for the task pods. For KUBERNETES executor only.",
 				Optional:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of the Deployment.",
+				MarkdownDescription: "The type of the Deployment. One of `DEDICATED`, `HYBRID` or `STANDARD`.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.DeploymentTypeDedicated, api.DeploymentTypeHybrid, api.DeploymentTypeStandard),
+				},
 			},
-			"worker_queues": schema.ListNestedAttribute{
+			"worker_queues": schema.MapNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"astro_machine": schema.StringAttribute{
-							Required: true,
+							MarkdownDescription: "The Astro machine type of the queue's workers, e.g. `A5`. Required for `CELERY` on `STANDARD` and `DEDICATED` Deployments.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
 						},
 						"id": schema.StringAttribute{
 							Computed: true,
@@ -204,15 +330,42 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 						"min_worker_count": schema.Int64Attribute{
 							Required: true,
 						},
-						"name": schema.StringAttribute{
-							Required: true,
+						"node_pool_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the node pool the queue's pods run on. Required for `HYBRID` Deployments.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"pod_cpu": schema.StringAttribute{
+							MarkdownDescription: "The CPU of each pod in the queue, in number of CPU cores. Can only be set with the `KUBERNETES` executor, otherwise it follows from `astro_machine`.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								cpuQuantityValidator{},
+							},
+						},
+						"pod_memory": schema.StringAttribute{
+							MarkdownDescription: "The memory of each pod in the queue, in `Gi`. Can only be set with the `KUBERNETES` executor, otherwise it follows from `astro_machine`.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								memoryQuantityValidator{},
+							},
 						},
 						"worker_concurrency": schema.Int64Attribute{
 							Required: true,
 						},
 					},
 				},
-				MarkdownDescription: "The list of worker queues configured for the Deployment. Applies only when `Executor` is `CELERY`. At least 1 worker queue is needed. All Deployments need at least 1 worker queue called `default`.",
+				MarkdownDescription: "The worker queues configured for the Deployment, keyed by queue name. Applies only when `Executor` is `CELERY`. At least 1 worker queue is needed. All Deployments need at least 1 worker queue called `default`. Queues created with `astronomer_deployment_worker_queue` are left out of this list.",
 				Optional:            true,
 			},
 			"workload_identity": schema.StringAttribute{
@@ -250,24 +403,169 @@ func (r *DeploymentResource) Configure(ctx context.Context, req resource.Configu
 	r.organizationId = provider.OrganizationId
 }
 
-func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *DeploymentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data DeploymentResourceModel
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// The configuration can't be read into the model while a whole list or block is still unknown,
+	// Create and Update run the same checks once it is known.
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		return
+	}
 
-	if data.CloudProvider.ValueString() == "" && data.ClusterId.ValueString() == "" {
-		resp.Diagnostics.AddError(
-			"Validation Error",
-			"cluster_id or cloud_provider must be specified",
-		)
+	resp.Diagnostics.Append(validateDeploymentResourceConfig(data)...)
+}
+
+// ModifyPlan requires a worker queue for new CELERY Deployments, re-plans the queue sizes the API
+// derives and describes what Update does to the worker queues when the executor changes or the
+// default queue is dropped. An existing Deployment switching to CELERY gets a default queue when
+// none is configured, so this can't be checked in ValidateConfig.
+func (r *DeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
 	}
-	if data.Executor.ValueString() == "CELERY" && len(data.WorkerQueues) == 0 {
-		resp.Diagnostics.AddError(
-			"Validation Error",
-			"Must provide at least one default worker queue when using CELERY executor.",
-		)
+
+	var executor types.String
+	var workerQueues types.Map
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("executor"), &executor)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("worker_queues"), &workerQueues)...)
+
+	if resp.Diagnostics.HasError() || executor.IsUnknown() || workerQueues.IsUnknown() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if executor.ValueString() == api.DeploymentExecutorCelery && len(workerQueues.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("worker_queues"), "Validation Error", "Must provide at least one default worker queue when using CELERY executor.")
+		}
+		return
+	}
+
+	var currentExecutor types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("executor"), &currentExecutor)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planWorkerQueueSizes(ctx, req, resp, !executor.Equal(currentExecutor))
+
+	// Queues with unknown values can't be read yet, they're planned again once they're known
+	var plannedQueues, currentQueues map[string]WorkerQueueModel
+	var diags diag.Diagnostics
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("worker_queues"), &plannedQueues)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("worker_queues"), &currentQueues)...)
+	if diags.HasError() {
+		return
+	}
+
+	if executor.Equal(currentExecutor) {
+		// Update gives a CELERY Deployment a default queue again when worker_queues drops it
+		if executor.ValueString() == api.DeploymentExecutorCelery && hasDefaultWorkerQueue(currentQueues) && !hasDefaultWorkerQueue(plannedQueues) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("worker_queues"),
+				"Default Worker Queue Will Be Created",
+				fmt.Sprintf("worker_queues no longer has a default queue, so the Deployment's default queue is reset to %d to %d workers with a concurrency of %d. Keep it in worker_queues to size it.", defaultWorkerQueueMinWorkerCount, defaultWorkerQueueMaxWorkerCount, defaultWorkerQueueWorkerConcurrency),
+			)
+		}
+		return
 	}
 
+	switch executor.ValueString() {
+	case api.DeploymentExecutorKubernetes:
+		if hasDefaultWorkerQueue(plannedQueues) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("executor"),
+				"Worker Queues Will Be Deleted",
+				fmt.Sprintf("Switching the executor from %s to KUBERNETES deletes every worker queue but the default one in worker_queues, including queues managed by astronomer_deployment_worker_queue.", currentExecutor.ValueString()),
+			)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("executor"),
+				"Worker Queues Will Be Deleted",
+				fmt.Sprintf("Switching the executor from %s to KUBERNETES deletes every worker queue, including the default one and queues managed by astronomer_deployment_worker_queue, since worker_queues has no default queue.", currentExecutor.ValueString()),
+			)
+		}
+	case api.DeploymentExecutorCelery:
+		if len(workerQueues.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("executor"),
+				"Default Worker Queue Will Be Created",
+				fmt.Sprintf("Switching the executor from %s to CELERY creates a default worker queue with %d to %d workers, set worker_queues to size it.", currentExecutor.ValueString(), defaultWorkerQueueMinWorkerCount, defaultWorkerQueueMaxWorkerCount),
+			)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("executor"),
+				"Worker Queues Will Be Replaced",
+				fmt.Sprintf("Switching the executor from %s to CELERY replaces the Deployment's worker queues with worker_queues.", currentExecutor.ValueString()),
+			)
+		}
+	}
+}
+
+func hasDefaultWorkerQueue(workerQueues map[string]WorkerQueueModel) bool {
+	for _, value := range workerQueues {
+		if value.IsDefault.ValueBool() {
+			return true
+		}
+	}
+	return false
+}
+
+// planWorkerQueueSizes marks the unconfigured astro_machine, pod_cpu and pod_memory of a queue as
+// unknown when the queue's size changes. The API derives them from each other, e.g. the pod size of
+// a CELERY queue follows from its Astro machine, so the values kept by UseStateForUnknown would
+// be stale.
+func planWorkerQueueSizes(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, executorChanged bool) {
+	var plan, state, config map[string]WorkerQueueModel
+
+	// Queues with unknown values can't be read yet, they're planned again once they're known
+	var diags diag.Diagnostics
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("worker_queues"), &plan)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("worker_queues"), &state)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("worker_queues"), &config)...)
+	if diags.HasError() || plan == nil {
+		return
+	}
+
+	modified := false
+	for name, planned := range plan {
+		current, ok := state[name]
+		if !ok {
+			continue
+		}
+		if !executorChanged && planned.AstroMachine.Equal(current.AstroMachine) && planned.PodCpu.Equal(current.PodCpu) && planned.PodMemory.Equal(current.PodMemory) {
+			continue
+		}
+		configured := config[name]
+		if configured.AstroMachine.IsNull() {
+			planned.AstroMachine = types.StringUnknown()
+		}
+		if configured.PodCpu.IsNull() {
+			planned.PodCpu = types.StringUnknown()
+		}
+		if configured.PodMemory.IsNull() {
+			planned.PodMemory = types.StringUnknown()
+		}
+		plan[name] = planned
+		modified = true
+	}
+
+	if modified {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("worker_queues"), plan)...)
+	}
+}
+
+func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentResourceModel
+
+	var config DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	resp.Diagnostics.Append(validateDeploymentResourceConfig(config)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -280,15 +578,17 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		DefaultTaskPodCpu:    data.DefaultTaskPodCpu.ValueString(),
 		DefaultTaskPodMemory: data.DefaultTaskPodMemory.ValueString(),
 		Description:          data.Description.ValueString(),
-		EnvironmentVariables: loadEnvironmentVariablesFromTFState(data),
+		EnvironmentVariables: loadEnvironmentVariablesFromTFState(data, config, DeploymentResourceModel{}),
 		Executor:             data.Executor.ValueString(),
 		IsCicdEnforced:       data.IsCicdEnforced.ValueBool(),
 		IsDagDeployEnabled:   data.IsDagDeployEnabled.ValueBool(),
+		IsDevelopmentMode:    data.IsDevelopmentMode.ValueBool(),
 		IsHighAvailability:   data.IsHighAvailability.ValueBool(),
 		Name:                 data.Name.ValueString(),
 		Region:               data.Region.ValueString(),
 		ResourceQuotaCpu:     data.ResourceQuotaCpu.ValueString(),
 		ResourceQuotaMemory:  data.ResourceQuotaMemory.ValueString(),
+		ScalingSpec:          loadScalingSpecFromTFState(data),
 		SchedulerSize:        data.SchedulerSize.ValueString(),
 		TaskPodNodePoolId:    data.TaskPodNodePoolId.ValueString(),
 		Type:                 data.Type.ValueString(),
@@ -303,9 +603,10 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	for deployResponse.Status != api.DeploymentStatusHealthy {
-		deployResponse, _ = api.GetDeployment(r.token, r.organizationId, deployResponse.Id)
-		time.Sleep(1 * time.Second)
+	deployResponse, err = waitForDeploymentHealthy(ctx, r.token, r.organizationId, deployResponse, "")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
 	}
 
 	data.CloudProvider = types.StringValue(strings.ToUpper(deployResponse.CloudProvider))
@@ -319,8 +620,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		data.Region = types.StringValue(deployResponse.Region)
 	}
 	data.Type = types.StringValue(deployResponse.Type)
-	workerQueuesDeployment := loadWorkerQueuesFromResponse(deployResponse)
-	data.WorkerQueues = workerQueuesDeployment
+	data.WorkerQueues = filterWorkerQueuesByName(loadWorkerQueuesFromResponse(deployResponse), data.WorkerQueues)
 	data.WorkloadIdentity = types.StringValue(deployResponse.WorkloadIdentity)
 	data.WorkspaceId = types.StringValue(deployResponse.WorkspaceId)
 
@@ -338,6 +638,10 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// The version is only tracked when it's configured or the Deployment is being imported
+	if !data.AstroRuntimeVersion.IsNull() || data.Name.IsNull() {
+		data.AstroRuntimeVersion = types.StringValue(deployment.RuntimeVersion)
+	}
 	data.CloudProvider = types.StringValue(strings.ToUpper(deployment.CloudProvider))
 	if data.ClusterId.ValueString() != "" {
 		data.ClusterId = types.StringValue(deployment.ClusterId)
@@ -346,11 +650,25 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.DefaultTaskPodCpu = types.StringValue(deployment.DefaultTaskPodCpu)
 	data.DefaultTaskPodMemory = types.StringValue(deployment.DefaultTaskPodMemory)
 	data.Description = types.StringValue(deployment.Description)
-	data.EnvironmentVariables = loadEnvironmentVariablesFromResponse(deployment, data)
+	// Variables missing from state are left to astronomer_deployment_environment_variable, unless
+	// the Deployment is being imported
+	if data.Name.IsNull() && len(deployment.EnvironmentVariables) > 0 {
+		data.EnvironmentVariables = loadEnvironmentVariablesFromResponse(deployment, data)
+	} else {
+		data.EnvironmentVariables = filterEnvironmentVariablesByKey(loadEnvironmentVariablesFromResponse(deployment, data), data.EnvironmentVariables)
+	}
 	data.Executor = types.StringValue(deployment.Executor)
 	data.IsCicdEnforced = types.BoolValue(deployment.IsCicdEnforced)
 	data.IsDagDeployEnabled = types.BoolValue(deployment.IsDagDeployEnabled)
+	data.IsDevelopmentMode = types.BoolValue(deployment.IsDevelopmentMode)
 	data.IsHighAvailability = types.BoolValue(deployment.IsHighAvailability)
+	// Queues missing from state are left to astronomer_deployment_worker_queue, unless
+	// the Deployment is being imported
+	workerQueues := loadWorkerQueuesFromResponse(deployment)
+	if !data.Name.IsNull() {
+		workerQueues = filterWorkerQueuesByName(workerQueues, data.WorkerQueues)
+	}
+	data.WorkerQueues = workerQueues
 
 	data.Name = types.StringValue(deployment.Name)
 	if data.Region.ValueString() != "" || deployment.Region != "" {
@@ -358,10 +676,13 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	data.ResourceQuotaCpu = types.StringValue(deployment.ResourceQuotaCpu)
 	data.ResourceQuotaMemory = types.StringValue(deployment.ResourceQuotaMemory)
+	if data.ScalingSpec != nil || hasHibernationSchedules(deployment) {
+		data.ScalingSpec = loadScalingSpecFromResponse(deployment)
+	}
 	data.SchedulerSize = types.StringValue(deployment.SchedulerSize)
-
-	workerQueues := loadWorkerQueuesFromResponse(deployment)
-	data.WorkerQueues = workerQueues
+	if !data.TaskPodNodePoolId.IsNull() {
+		data.TaskPodNodePoolId = types.StringValue(deployment.TaskPodNodePoolId)
+	}
 	data.Type = types.StringValue(deployment.Type)
 	data.WorkloadIdentity = types.StringValue(deployment.WorkloadIdentity)
 	data.WorkspaceId = types.StringValue(deployment.WorkspaceId)
@@ -374,84 +695,377 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func loadWorkerQueuesFromTFState(data DeploymentResourceModel) []api.WorkerQueue {
+	var names []string
+	for name := range data.WorkerQueues {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
 	var workerQueues []api.WorkerQueue
-	for _, value := range data.WorkerQueues {
+	for _, name := range names {
+		value := data.WorkerQueues[name]
 		workerQueues = append(workerQueues, api.WorkerQueue{
 			AstroMachine:      value.AstroMachine.ValueString(),
 			Id:                value.Id.ValueString(),
 			IsDefault:         value.IsDefault.ValueBool(),
 			MaxWorkerCount:    int(value.MaxWorkerCount.ValueInt64()),
 			MinWorkerCount:    int(value.MinWorkerCount.ValueInt64()),
-			Name:              value.Name.ValueString(),
+			Name:              name,
+			NodePoolId:        value.NodePoolId.ValueString(),
+			PodCpu:            value.PodCpu.ValueString(),
+			PodMemory:         value.PodMemory.ValueString(),
 			WorkerConcurrency: int(value.WorkerConcurrency.ValueInt64()),
 		})
 	}
+	return createWorkerQueueRequests(workerQueues, data.Type.ValueString(), data.Executor.ValueString())
+}
+
+// validateDeploymentResourceConfig checks the settings that depend on the Deployment's type and
+// executor. It takes the configuration rather than the plan, so values computed by the API don't
+// trip it. Values that are still unknown are skipped, the checks run again at apply.
+func validateDeploymentResourceConfig(data DeploymentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	deploymentType := data.Type.ValueString()
+	executor := data.Executor.ValueString()
+
+	switch deploymentType {
+	case api.DeploymentTypeStandard:
+		if !data.ClusterId.IsNull() {
+			diags.AddAttributeError(path.Root("cluster_id"), "Validation Error", "STANDARD Deployments run on a shared cluster, set cloud_provider and region instead of cluster_id.")
+		}
+		if data.CloudProvider.IsNull() {
+			diags.AddAttributeError(path.Root("cloud_provider"), "Validation Error", "cloud_provider is required for STANDARD Deployments.")
+		}
+		if data.Region.IsNull() {
+			diags.AddAttributeError(path.Root("region"), "Validation Error", "region is required for STANDARD Deployments.")
+		}
+	case api.DeploymentTypeDedicated, api.DeploymentTypeHybrid:
+		if data.ClusterId.IsNull() {
+			diags.AddAttributeError(path.Root("cluster_id"), "Validation Error", fmt.Sprintf("cluster_id is required for %s Deployments.", deploymentType))
+		}
+		if !data.CloudProvider.IsNull() {
+			diags.AddAttributeError(path.Root("cloud_provider"), "Validation Error", fmt.Sprintf("%s Deployments take their cloud provider from cluster_id, remove cloud_provider.", deploymentType))
+		}
+		if !data.Region.IsNull() {
+			diags.AddAttributeError(path.Root("region"), "Validation Error", fmt.Sprintf("%s Deployments take their region from cluster_id, remove region.", deploymentType))
+		}
+	default:
+		if data.ClusterId.IsNull() && data.CloudProvider.IsNull() {
+			diags.AddError("Validation Error", "cluster_id or cloud_provider must be specified")
+		}
+		if !data.CloudProvider.IsNull() && data.Region.IsNull() {
+			diags.AddAttributeError(path.Root("region"), "Validation Error", "region is required when cloud_provider is set.")
+		}
+	}
+
+	if data.IsDevelopmentMode.ValueBool() {
+		if deploymentType == api.DeploymentTypeHybrid {
+			diags.AddAttributeError(path.Root("is_development_mode"), "Validation Error", "is_development_mode is not available for HYBRID Deployments.")
+		}
+		if data.IsHighAvailability.ValueBool() {
+			diags.AddAttributeError(path.Root("is_high_availability"), "Validation Error", "Development Deployments can't be highly available, set is_high_availability to false.")
+		}
+	}
+	if data.ScalingSpec != nil && !data.IsDevelopmentMode.ValueBool() {
+		diags.AddAttributeError(path.Root("scaling_spec"), "Validation Error", "scaling_spec requires is_development_mode to be true.")
+	}
+	if !data.TaskPodNodePoolId.IsNull() && !data.Executor.IsUnknown() && executor != api.DeploymentExecutorKubernetes {
+		diags.AddAttributeError(path.Root("task_pod_node_pool_id"), "Validation Error", "task_pod_node_pool_id only applies to the KUBERNETES executor.")
+	}
+
+	if executor == api.DeploymentExecutorKubernetes && len(data.WorkerQueues) > 1 {
+		diags.AddAttributeError(path.Root("worker_queues"), "Validation Error", "The KUBERNETES executor only has the default worker queue.")
+	}
+
+	for key, value := range data.EnvironmentVariables {
+		envVarPath := path.Root("environment_variables").AtMapKey(key)
+		if value.IsSecret.IsUnknown() {
+			continue
+		}
+		if !value.ValueWo.IsNull() && !value.IsSecret.ValueBool() {
+			diags.AddAttributeError(envVarPath.AtName("value_wo"), "Validation Error", fmt.Sprintf("Environment variable %s must be a secret to use value_wo, set value instead.", key))
+		}
+		if !value.Value.IsNull() && value.IsSecret.ValueBool() {
+			diags.AddAttributeError(envVarPath.AtName("value"), "Validation Error", fmt.Sprintf("Environment variable %s is a secret, set value_wo instead of value, which is stored in the Terraform state.", key))
+		}
+	}
+
+	defaultQueues := 0
+	defaultsKnown := true
+	for name, value := range data.WorkerQueues {
+		queuePath := path.Root("worker_queues").AtMapKey(name)
+		if value.IsDefault.IsUnknown() {
+			defaultsKnown = false
+		} else if value.IsDefault.ValueBool() {
+			defaultQueues++
+			if name != defaultWorkerQueueName {
+				diags.AddAttributeError(queuePath.AtName("is_default"), "Validation Error", fmt.Sprintf("The default worker queue must be named default, got %s.", name))
+			}
+		}
+		if !value.MinWorkerCount.IsUnknown() && !value.MaxWorkerCount.IsUnknown() && value.MinWorkerCount.ValueInt64() > value.MaxWorkerCount.ValueInt64() {
+			diags.AddAttributeError(queuePath.AtName("min_worker_count"), "Validation Error", fmt.Sprintf("Worker queue %s has a min_worker_count greater than its max_worker_count.", name))
+		}
+		if deploymentType == api.DeploymentTypeHybrid {
+			if value.NodePoolId.IsNull() {
+				diags.AddAttributeError(queuePath.AtName("node_pool_id"), "Validation Error", fmt.Sprintf("Worker queue %s must set node_pool_id on a HYBRID Deployment.", name))
+			}
+			if !value.AstroMachine.IsNull() {
+				diags.AddAttributeError(queuePath.AtName("astro_machine"), "Validation Error", fmt.Sprintf("Worker queue %s can't set astro_machine on a HYBRID Deployment, use node_pool_id.", name))
+			}
+		} else if !data.Type.IsUnknown() {
+			if !value.NodePoolId.IsNull() {
+				diags.AddAttributeError(queuePath.AtName("node_pool_id"), "Validation Error", fmt.Sprintf("Worker queue %s can only set node_pool_id on a HYBRID Deployment.", name))
+			}
+			if executor == api.DeploymentExecutorCelery && value.AstroMachine.IsNull() {
+				diags.AddAttributeError(queuePath.AtName("astro_machine"), "Validation Error", fmt.Sprintf("Worker queue %s must set astro_machine.", name))
+			}
+		}
+		if !data.Executor.IsUnknown() && executor != api.DeploymentExecutorKubernetes && (!value.PodCpu.IsNull() || !value.PodMemory.IsNull()) {
+			diags.AddAttributeError(queuePath, "Validation Error", fmt.Sprintf("Worker queue %s can only set pod_cpu and pod_memory with the KUBERNETES executor.", name))
+		}
+	}
+	if len(data.WorkerQueues) > 0 && defaultsKnown && defaultQueues != 1 {
+		diags.AddAttributeError(path.Root("worker_queues"), "Validation Error", fmt.Sprintf("Exactly one worker queue must set is_default, got %d.", defaultQueues))
+	}
+	return diags
+}
+
+func filterWorkerQueuesByName(workerQueues map[string]WorkerQueueModel, names map[string]WorkerQueueModel) map[string]WorkerQueueModel {
+	if names == nil {
+		return nil
+	}
+	filtered := map[string]WorkerQueueModel{}
+	for name, value := range workerQueues {
+		if _, ok := names[name]; ok {
+			filtered[name] = value
+		}
+	}
+	return filtered
+}
+
+// loadUnmanagedWorkerQueues returns the Deployment's queues that are neither planned nor in prior
+// state, i.e. queues owned by astronomer_deployment_worker_queue.
+func loadUnmanagedWorkerQueues(deployment *api.DeploymentResponse, plan DeploymentResourceModel, state DeploymentResourceModel) []api.WorkerQueue {
+	var workerQueues []api.WorkerQueue
+	for _, value := range deployment.WorkerQueues {
+		_, planned := plan.WorkerQueues[value.Name]
+		_, inState := state.WorkerQueues[value.Name]
+		if !planned && !inState {
+			workerQueues = append(workerQueues, value)
+		}
+	}
 	return workerQueues
 }
 
-func loadEnvironmentVariablesFromTFState(data DeploymentResourceModel) []api.EnvironmentVariableRequest {
+func loadScalingSpecFromTFState(data DeploymentResourceModel) *api.DeploymentScalingSpec {
+	if data.ScalingSpec == nil || data.ScalingSpec.HibernationSpec == nil {
+		return nil
+	}
+	var schedules []api.DeploymentHibernationSchedule = []api.DeploymentHibernationSchedule{}
+	for _, value := range data.ScalingSpec.HibernationSpec.Schedules {
+		schedules = append(schedules, api.DeploymentHibernationSchedule{
+			Description:     value.Description.ValueString(),
+			HibernateAtCron: value.HibernateAtCron.ValueString(),
+			IsEnabled:       value.IsEnabled.ValueBool(),
+			WakeAtCron:      value.WakeAtCron.ValueString(),
+		})
+	}
+	return &api.DeploymentScalingSpec{
+		HibernationSpec: &api.DeploymentHibernationSpec{
+			Schedules: schedules,
+		},
+	}
+}
+
+func hasHibernationSchedules(deployment *api.DeploymentResponse) bool {
+	return deployment.ScalingSpec != nil && deployment.ScalingSpec.HibernationSpec != nil && len(deployment.ScalingSpec.HibernationSpec.Schedules) > 0
+}
+
+func loadScalingSpecFromResponse(deployment *api.DeploymentResponse) *DeploymentScalingSpecModel {
+	var schedules []DeploymentHibernationScheduleModel = []DeploymentHibernationScheduleModel{}
+	if hasHibernationSchedules(deployment) {
+		for _, value := range deployment.ScalingSpec.HibernationSpec.Schedules {
+			description := types.StringNull()
+			if value.Description != "" {
+				description = types.StringValue(value.Description)
+			}
+			schedules = append(schedules, DeploymentHibernationScheduleModel{
+				Description:     description,
+				HibernateAtCron: types.StringValue(value.HibernateAtCron),
+				IsEnabled:       types.BoolValue(value.IsEnabled),
+				WakeAtCron:      types.StringValue(value.WakeAtCron),
+			})
+		}
+	}
+	return &DeploymentScalingSpecModel{
+		HibernationSpec: &DeploymentHibernationSpecModel{
+			Schedules: schedules,
+		},
+	}
+}
+
+// loadEnvironmentVariablesFromTFState builds the environment variables of the plan. Write-only
+// values are only in the configuration, they're sent when the variable is new or its
+// value_wo_version changed and omitted otherwise, which keeps the secret the API already has.
+func loadEnvironmentVariablesFromTFState(data DeploymentResourceModel, config DeploymentResourceModel, state DeploymentResourceModel) []api.EnvironmentVariableRequest {
 	var envVars []api.EnvironmentVariableRequest = []api.EnvironmentVariableRequest{}
-	for _, value := range data.EnvironmentVariables {
-		envVars = append(envVars, api.EnvironmentVariableRequest{
+	var keys []string
+	for key := range data.EnvironmentVariables {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		value := data.EnvironmentVariables[key]
+		envVar := api.EnvironmentVariableRequest{
 			IsSecret: value.IsSecret.ValueBool(),
-			Key:      value.Key.ValueString(),
+			Key:      key,
 			Value:    value.Value.ValueString(),
-		})
+		}
+		if configured, ok := config.EnvironmentVariables[key]; ok && !configured.ValueWo.IsNull() {
+			current, ok := state.EnvironmentVariables[key]
+			if !ok || !current.ValueWoVersion.Equal(value.ValueWoVersion) || !current.IsSecret.ValueBool() {
+				envVar.Value = configured.ValueWo.ValueString()
+			}
+		}
+		envVars = append(envVars, envVar)
 	}
 	return envVars
 }
 
-func loadWorkerQueuesFromResponse(deployment *api.DeploymentResponse) []WorkerQueueModel {
-	var workerQueues []WorkerQueueModel
+func loadWorkerQueuesFromResponse(deployment *api.DeploymentResponse) map[string]WorkerQueueModel {
+	workerQueues := map[string]WorkerQueueModel{}
 	for _, value := range deployment.WorkerQueues {
-		workerQueues = append(workerQueues, WorkerQueueModel{
+		workerQueues[value.Name] = WorkerQueueModel{
 			AstroMachine:      types.StringValue(value.AstroMachine),
 			Id:                types.StringValue(value.Id),
 			IsDefault:         types.BoolValue(value.IsDefault),
 			MaxWorkerCount:    types.Int64Value(int64(value.MaxWorkerCount)),
 			MinWorkerCount:    types.Int64Value(int64(value.MinWorkerCount)),
-			Name:              types.StringValue(value.Name),
+			NodePoolId:        types.StringValue(value.NodePoolId),
+			PodCpu:            types.StringValue(value.PodCpu),
+			PodMemory:         types.StringValue(value.PodMemory),
 			WorkerConcurrency: types.Int64Value(int64(value.WorkerConcurrency)),
-		})
+		}
 	}
 	return workerQueues
 }
 
-func loadEnvironmentVariablesFromResponse(deployment *api.DeploymentResponse, data DeploymentResourceModel) []EnvironmentVariableModel {
-	var envVars []EnvironmentVariableModel
+// loadEnvironmentVariablesFromResponse reads every variable of the Deployment, so non-secret values
+// changed outside of Terraform show up as drift. Secret values can't be read, they keep the state.
+func loadEnvironmentVariablesFromResponse(deployment *api.DeploymentResponse, data DeploymentResourceModel) map[string]EnvironmentVariableModel {
+	envVars := map[string]EnvironmentVariableModel{}
 
 	for _, value := range deployment.EnvironmentVariables {
-		strValue := types.StringValue(value.Value)
-		//Use state value if secret since it can't be retrieved
+		current, ok := data.EnvironmentVariables[value.Key]
+		envVar := EnvironmentVariableModel{
+			IsSecret:       types.BoolValue(value.IsSecret),
+			Value:          types.StringValue(value.Value),
+			ValueWo:        types.StringNull(),
+			ValueWoVersion: types.Int64Null(),
+		}
+		if ok {
+			envVar.ValueWoVersion = current.ValueWoVersion
+		}
 		if value.IsSecret {
-			idx := slices.IndexFunc(data.EnvironmentVariables, func(envVar EnvironmentVariableModel) bool { return envVar.Key.ValueString() == value.Key })
-			if idx != -1 {
-				strValue = data.EnvironmentVariables[idx].Value
+			envVar.Value = types.StringNull()
+			if ok {
+				envVar.Value = current.Value
 			}
+		}
+		envVars[value.Key] = envVar
+	}
+	return envVars
+}
 
+func filterEnvironmentVariablesByKey(envVars map[string]EnvironmentVariableModel, keys map[string]EnvironmentVariableModel) map[string]EnvironmentVariableModel {
+	if keys == nil {
+		return nil
+	}
+	filtered := map[string]EnvironmentVariableModel{}
+	for key, value := range envVars {
+		if _, ok := keys[key]; ok {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
+
+// loadUnmanagedEnvironmentVariables returns the Deployment's variables that are neither planned nor
+// in prior state, i.e. variables owned by astronomer_deployment_environment_variable.
+func loadUnmanagedEnvironmentVariables(deployment *api.DeploymentResponse, plan DeploymentResourceModel, state DeploymentResourceModel) []api.EnvironmentVariableResponse {
+	var envVars []api.EnvironmentVariableResponse
+	for _, value := range deployment.EnvironmentVariables {
+		_, planned := plan.EnvironmentVariables[value.Key]
+		_, inState := state.EnvironmentVariables[value.Key]
+		if !planned && !inState {
+			envVars = append(envVars, value)
 		}
-		envVars = append(envVars, EnvironmentVariableModel{
-			IsSecret: types.BoolValue(value.IsSecret),
-			Key:      types.StringValue(value.Key),
-			Value:    strValue,
-		})
 	}
 	return envVars
 }
 
 func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data DeploymentResourceModel
+	var state DeploymentResourceModel
+	var config DeploymentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(validateDeploymentResourceConfig(config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	unlock := lockDeployment(data.Id.ValueString())
+	defer unlock()
+
+	deployment, err := api.GetDeployment(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment, got error: %s", err))
+		return
+	}
+
 	workerQueues := loadWorkerQueuesFromTFState(data)
-	envVars := loadEnvironmentVariablesFromTFState(data)
+	switch data.Executor.ValueString() {
+	case api.DeploymentExecutorCelery:
+		// Queues of the KUBERNETES executor don't carry over, they have no astro_machine
+		if state.Executor.ValueString() == api.DeploymentExecutorCelery {
+			unmanagedWorkerQueues := loadUnmanagedWorkerQueues(deployment, data, state)
+			workerQueues = append(workerQueues, createWorkerQueueRequests(unmanagedWorkerQueues, data.Type.ValueString(), data.Executor.ValueString())...)
+		}
+		if !slices.ContainsFunc(workerQueues, func(workerQueue api.WorkerQueue) bool { return workerQueue.IsDefault }) {
+			workerQueues = append(workerQueues, createDefaultWorkerQueueRequest(deployment, data.Type.ValueString()))
+		}
+	case api.DeploymentExecutorKubernetes:
+		workerQueues = slices.DeleteFunc(workerQueues, func(workerQueue api.WorkerQueue) bool { return !workerQueue.IsDefault })
+	}
+	envVars := loadEnvironmentVariablesFromTFState(data, config, state)
+
+	// Keep the variables managed by astronomer_deployment_environment_variable
+	envVars = append(envVars, createEnvironmentVariableRequestFromResponse(loadUnmanagedEnvironmentVariables(deployment, data, state))...)
+
+	// Keep the current scaling spec unless hibernation schedules are or were configured here, and
+	// always keep the override owned by astronomer_deployment_hibernation_override until it ends
+	scalingSpec := createScalingSpecRequestFromResponse(deployment.ScalingSpec)
+	if data.ScalingSpec != nil || state.ScalingSpec != nil {
+		scalingSpec = loadScalingSpecFromTFState(data)
+		if scalingSpec == nil {
+			scalingSpec = &api.DeploymentScalingSpec{HibernationSpec: &api.DeploymentHibernationSpec{Schedules: []api.DeploymentHibernationSchedule{}}}
+		}
+		scalingSpec.HibernationSpec.Override = loadActiveHibernationOverride(deployment.ScalingSpec)
+	}
+
+	// Only send the runtime version when it changes, the update endpoint upgrades the Deployment
+	// whenever it's set. Downgrades never get here, they replace the Deployment.
+	runtimeVersion := ""
+	if !data.AstroRuntimeVersion.IsNull() && !data.AstroRuntimeVersion.Equal(state.AstroRuntimeVersion) {
+		runtimeVersion = data.AstroRuntimeVersion.ValueString()
+	}
 
 	deploymentUpdateRequest := &api.DeploymentUpdateRequest{
+		AstroRuntimeVersion:  runtimeVersion,
 		DefaultTaskPodCpu:    data.DefaultTaskPodCpu.ValueString(),
 		DefaultTaskPodMemory: data.DefaultTaskPodMemory.ValueString(),
 		Description:          data.Description.ValueString(),
@@ -459,11 +1073,14 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		Executor:             data.Executor.ValueString(),
 		IsCicdEnforced:       data.IsCicdEnforced.ValueBool(),
 		IsDagDeployEnabled:   data.IsDagDeployEnabled.ValueBool(),
+		IsDevelopmentMode:    data.IsDevelopmentMode.ValueBool(),
 		IsHighAvailability:   data.IsHighAvailability.ValueBool(),
 		Name:                 data.Name.ValueString(),
 		ResourceQuotaCpu:     data.ResourceQuotaCpu.ValueString(),
 		ResourceQuotaMemory:  data.ResourceQuotaMemory.ValueString(),
+		ScalingSpec:          scalingSpec,
 		SchedulerSize:        data.SchedulerSize.ValueString(),
+		TaskPodNodePoolId:    data.TaskPodNodePoolId.ValueString(),
 		Type:                 data.Type.ValueString(),
 		WorkerQueues:         workerQueues,
 		WorkloadIdentity:     data.WorkloadIdentity.ValueString(),
@@ -477,7 +1094,15 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	data.WorkerQueues = loadWorkerQueuesFromResponse(deployResponse)
+	if runtimeVersion != "" {
+		deployResponse, err = waitForDeploymentHealthy(ctx, r.token, r.organizationId, deployResponse, runtimeVersion)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+
+	data.WorkerQueues = filterWorkerQueuesByName(loadWorkerQueuesFromResponse(deployResponse), data.WorkerQueues)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
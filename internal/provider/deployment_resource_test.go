@@ -3,9 +3,14 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccDeploymentResource(t *testing.T) {
@@ -24,10 +29,9 @@ func TestAccDeploymentResource(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:            "astronomer_deployment.test",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"astro_runtime_version"},
+				ResourceName:      "astronomer_deployment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 			{
 				Config: testDeploymentResourceConfig("TestDeploymentUpdate"),
@@ -35,50 +39,258 @@ func TestAccDeploymentResource(t *testing.T) {
 					resource.TestCheckResourceAttr("astronomer_deployment.test", "name", "TestDeploymentUpdate"),
 				),
 			},
+			{
+				Config: strings.Replace(testDeploymentResourceConfig("TestDeploymentUpdate"), `astro_machine:      "A5"`, `astro_machine:      "A10"`, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "worker_queues.default.astro_machine", "A10"),
+					resource.TestCheckResourceAttrSet("astronomer_deployment.test", "worker_queues.default.pod_cpu"),
+					resource.TestCheckResourceAttrSet("astronomer_deployment.test", "worker_queues.default.pod_memory"),
+				),
+			},
+			{
+				Config: strings.Replace(testDeploymentResourceConfig("TestDeploymentUpdate"), `astro_runtime_version = "9.1.0"`, `astro_runtime_version = "9.2.0"`, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("astronomer_deployment.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "astro_runtime_version", "9.2.0"),
+				),
+			},
+			{
+				Config: strings.Replace(testDeploymentResourceConfig("TestDeploymentUpdate"), `astro_runtime_version = "9.1.0"`, `astro_runtime_version = "9.2.0"
+	environment_variables = {
+		TF_ACC_VARIABLE = { is_secret = false, value = "value" }
+		TF_ACC_SECRET = { is_secret = true, value = "secret" }
+	}`, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_VARIABLE.value", "value"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_SECRET.is_secret", "true"),
+				),
+			},
 		},
 	})
 }
 
-func testDeploymentResourceConfig(name string) string {
-	orgId := os.Getenv("ORGANIZATION_ID")
-	return fmt.Sprintf(`
-provider "astronomer" {
-	organization_id = %[1]q
+func TestAccDeploymentResourceExecutorChange(t *testing.T) {
+	celeryConfig := testDeploymentResourceConfig("TestDeploymentExecutor")
+	kubernetesConfig := strings.NewReplacer(
+		`executor = "CELERY"`, `executor = "KUBERNETES"`,
+		"\t\tastro_machine:      \"A5\",\n", "",
+	).Replace(celeryConfig)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: celeryConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "executor", "CELERY"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "worker_queues.default.astro_machine", "A5"),
+				),
+			},
+			{
+				Config: kubernetesConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("astronomer_deployment.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "executor", "KUBERNETES"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "worker_queues.%", "1"),
+					resource.TestCheckResourceAttrSet("astronomer_deployment.test", "worker_queues.default.pod_cpu"),
+					resource.TestCheckResourceAttrSet("astronomer_deployment.test", "worker_queues.default.pod_memory"),
+				),
+			},
+			{
+				Config: celeryConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("astronomer_deployment.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "executor", "CELERY"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "worker_queues.%", "1"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "worker_queues.default.astro_machine", "A5"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "worker_queues.default.is_default", "true"),
+				),
+			},
+		},
+	})
 }
 
-resource "astronomer_workspace" "test" {
-	name = "TestDeploymentWorkspace"
-	cicd_enforced_default = true
-	description = "TestAccDataSource"
+func TestAccDeploymentResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(testDeploymentResourceConfig("TestDeployment"), `executor = "CELERY"`, `executor = "LOCAL"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config:      strings.Replace(testDeploymentResourceConfig("TestDeployment"), `default_task_pod_cpu = "0.5"`, `default_task_pod_cpu = "500m"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is not a CPU quantity`),
+			},
+			{
+				Config:      strings.Replace(testDeploymentResourceConfig("TestDeployment"), `resource_quota_memory = "320Gi"`, `resource_quota_memory = "320"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is not a memory quantity`),
+			},
+			{
+				Config:      strings.Replace(testDeploymentResourceConfig("TestDeployment"), `default_task_pod_memory = "1Gi"`, `default_task_pod_memory = "2Gi"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be twice default_task_pod_cpu`),
+			},
+			{
+				Config:      strings.Replace(testDeploymentResourceConfig("TestDeployment"), `region = "us-east-1"`, ``, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`region is required for STANDARD Deployments`),
+			},
+			{
+				Config:      strings.Replace(testDeploymentResourceConfig("TestDeployment"), `type = "STANDARD"`, `type = "DEDICATED"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`cluster_id is required for DEDICATED Deployments`),
+			},
+			{
+				Config:      strings.Replace(testDeploymentResourceConfig("TestDeployment"), `default = {`, `main = {`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`default worker queue must be named default`),
+			},
+			{
+				Config:      strings.Replace(testDeploymentResourceConfig("TestDeployment"), `is_default:         true`, `is_default:         false`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly one worker queue must set is_default`),
+			},
+			{
+				Config:      strings.Replace(testDeploymentResourceConfig("TestDeployment"), `min_worker_count:    1`, `min_worker_count:    2`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`min_worker_count greater than its\s+max_worker_count`),
+			},
+			{
+				Config:      strings.Replace(testDeploymentResourceConfig("TestDeployment"), `scheduler_size = "MEDIUM"`, "scheduler_size = \"MEDIUM\"\n\ttask_pod_node_pool_id = \"pool\"", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`task_pod_node_pool_id only applies to the KUBERNETES executor`),
+			},
+			{
+				Config:      strings.NewReplacer("worker_queues = {", "worker_queues = { for name, queue in {", "\t}\n}", "\t} : name => queue if false }\n}").Replace(testDeploymentResourceConfig("TestDeployment")),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Must provide at least one default worker queue`),
+			},
+			{
+				Config: strings.Replace(testDeploymentResourceConfig("TestDeployment"), `astro_runtime_version = "9.1.0"`, `astro_runtime_version = "9.1.0"
+	environment_variables = {
+		TF_ACC_SECRET = { is_secret = true, value = "secret" }
+	}`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Environment variable TF_ACC_SECRET is a secret, set value_wo instead of\s+value`),
+			},
+		},
+	})
 }
 
-resource "astronomer_deployment" "test" {
-	astro_runtime_version = "9.1.0"
-	cloud_provider = "AWS"
-	default_task_pod_cpu = "0.5"
-	default_task_pod_memory = "1Gi"
-	description = "A Standard Deployment"
-	executor = "CELERY"
-	is_dag_deploy_enabled = true
-	is_cicd_enforced = true
-	is_high_availability = true
-	name = %[2]q
-	region = "us-east-1"
-	resource_quota_cpu = "160"
-	resource_quota_memory = "320Gi"
-	scheduler_size = "MEDIUM"
-	type = "STANDARD"
-	workspace_id = astronomer_workspace.test.id
-	worker_queues = [
-		{
-		astro_machine:      "A5",
-		is_default:         true,
-		max_worker_count:    1,
-		min_worker_count:    1,
-		name:              "default",
-		worker_concurrency: 1,
+func TestAccDeploymentResourceWriteOnly(t *testing.T) {
+	writeOnlyConfig := func(valueVersion int) string {
+		return strings.Replace(testDeploymentResourceConfig("TestDeploymentWriteOnly"), `astro_runtime_version = "9.1.0"`, fmt.Sprintf(`astro_runtime_version = "9.1.0"
+	environment_variables = {
+		TF_ACC_SECRET = { is_secret = true, value_wo = "SECRET_VALUE_%[1]d", value_wo_version = %[1]d }
+	}`, valueVersion), 1)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
 		},
-	]
+		Steps: []resource.TestStep{
+			{
+				Config: writeOnlyConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_SECRET.is_secret", "true"),
+					resource.TestCheckNoResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_SECRET.value"),
+					resource.TestCheckNoResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_SECRET.value_wo"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_SECRET.value_wo_version", "1"),
+				),
+			},
+			{
+				Config: writeOnlyConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_SECRET.value_wo"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_SECRET.value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccDeploymentResourceStateUpgrade applies a configuration with a release from before
+// environment_variables and worker_queues became maps, then applies it with this provider. The release is taken from
+// ASTRONOMER_PREVIOUS_PROVIDER_VERSION, e.g. "0.1.0".
+func TestAccDeploymentResourceStateUpgrade(t *testing.T) {
+	previousVersion := os.Getenv("ASTRONOMER_PREVIOUS_PROVIDER_VERSION")
+	if previousVersion == "" {
+		t.Skip("ASTRONOMER_PREVIOUS_PROVIDER_VERSION must be set to a release with list environment_variables and worker_queues")
+	}
+	// The dev_overrides used in CI would replace the released provider with this one
+	t.Setenv("TF_CLI_CONFIG_FILE", "")
+
+	environmentVariables := `astro_runtime_version = "9.1.0"
+	environment_variables = {
+		TF_ACC_VARIABLE = { is_secret = false, value = "value" }
+	}`
+	previousEnvironmentVariables := `astro_runtime_version = "9.1.0"
+	environment_variables = [
+		{ key = "TF_ACC_VARIABLE", is_secret = false, value = "value" },
+	]`
+
+	var queueId string
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"astronomer": {
+						Source:            "gk-consulting/astronomer",
+						VersionConstraint: previousVersion,
+					},
+				},
+				Config: strings.NewReplacer(
+					`astro_runtime_version = "9.1.0"`, previousEnvironmentVariables,
+					"worker_queues = {\n\t\tdefault = {", "worker_queues = [\n\t\t{\n\t\tname:               \"default\",",
+					"\t\t},\n\t}\n}", "\t\t},\n\t]\n}",
+				).Replace(testDeploymentResourceConfig("TestDeploymentStateUpgrade")),
+				Check: resource.TestCheckResourceAttrWith("astronomer_deployment.test", "worker_queues.0.id", func(value string) error {
+					queueId = value
+					return nil
+				}),
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config:                   strings.Replace(testDeploymentResourceConfig("TestDeploymentStateUpgrade"), `astro_runtime_version = "9.1.0"`, environmentVariables, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "environment_variables.TF_ACC_VARIABLE.value", "value"),
+					// The default queue is updated in place, not recreated
+					resource.TestCheckResourceAttrWith("astronomer_deployment.test", "worker_queues.default.id", func(value string) error {
+						if value != queueId {
+							return fmt.Errorf("expected worker queue %s, got %s", queueId, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
 }
-`, orgId, name)
+
+func testDeploymentResourceConfig(name string) string {
+	return strings.NewReplacer(
+		"is_high_availability = false", "is_high_availability = true",
+		`scheduler_size = "SMALL"`, `scheduler_size = "MEDIUM"`,
+	).Replace(testAccDeploymentConfig(name, ""))
 }
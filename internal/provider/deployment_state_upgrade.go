@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+var _ resource.ResourceWithUpgradeState = &DeploymentResource{}
+
+// UpgradeState rewrites the raw JSON of older states. Each prior version applies every later
+// upgrade in turn, so the old schemas don't have to be kept around.
+func (r *DeploymentResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeDeploymentResourceState(upgradeDeploymentEnvironmentVariablesToMap, upgradeDeploymentWorkerQueuesToMap)},
+		1: {StateUpgrader: upgradeDeploymentResourceState(upgradeDeploymentWorkerQueuesToMap)},
+	}
+}
+
+func upgradeDeploymentResourceState(upgrades ...func(state map[string]any)) func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) {
+	return func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+		if req.RawState == nil || req.RawState.JSON == nil {
+			resp.Diagnostics.AddError("Unable to Upgrade State", "The prior astronomer_deployment state is missing.")
+			return
+		}
+
+		var state map[string]any
+		decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+		decoder.UseNumber()
+		if err := decoder.Decode(&state); err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade State", fmt.Sprintf("Unable to read the prior astronomer_deployment state, got error: %s", err))
+			return
+		}
+
+		for _, upgrade := range upgrades {
+			upgrade(state)
+		}
+
+		upgraded, err := json.Marshal(state)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade State", fmt.Sprintf("Unable to write the upgraded astronomer_deployment state, got error: %s", err))
+			return
+		}
+		resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+	}
+}
+
+// upgradeDeploymentEnvironmentVariablesToMap turns the list of environment variables of version 0
+// into the map keyed by variable key. A later variable with the same key replaces an earlier one,
+// the next refresh reads the variable back from the API.
+func upgradeDeploymentEnvironmentVariablesToMap(state map[string]any) {
+	envVars, ok := state["environment_variables"].([]any)
+	if !ok {
+		return
+	}
+
+	upgraded := map[string]any{}
+	for _, value := range envVars {
+		envVar, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		key, _ := envVar["key"].(string)
+		delete(envVar, "key")
+		upgraded[key] = envVar
+	}
+	state["environment_variables"] = upgraded
+}
+
+// upgradeDeploymentWorkerQueuesToMap turns the list of worker queues of versions 0 and 1 into the
+// map keyed by queue name. The queue IDs are kept, so existing queues are updated rather than
+// recreated. Queue names are unique on a Deployment, a later duplicate replaces an earlier one.
+func upgradeDeploymentWorkerQueuesToMap(state map[string]any) {
+	workerQueues, ok := state["worker_queues"].([]any)
+	if !ok {
+		return
+	}
+
+	upgraded := map[string]any{}
+	for _, value := range workerQueues {
+		workerQueue, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := workerQueue["name"].(string)
+		delete(workerQueue, "name")
+		upgraded[name] = workerQueue
+	}
+	state["worker_queues"] = upgraded
+}
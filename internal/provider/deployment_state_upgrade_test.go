@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestUpgradeDeploymentEnvironmentVariablesToMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    string
+		expected string
+	}{
+		{
+			name:     "missing",
+			state:    `{"name": "deployment"}`,
+			expected: `{"name": "deployment"}`,
+		},
+		{
+			name:     "null",
+			state:    `{"environment_variables": null}`,
+			expected: `{"environment_variables": null}`,
+		},
+		{
+			name:     "empty",
+			state:    `{"environment_variables": []}`,
+			expected: `{"environment_variables": {}}`,
+		},
+		{
+			name:     "variables",
+			state:    `{"environment_variables": [{"key": "ONE", "value": "1", "is_secret": false}, {"key": "TWO", "value": "2", "is_secret": false}]}`,
+			expected: `{"environment_variables": {"ONE": {"value": "1", "is_secret": false}, "TWO": {"value": "2", "is_secret": false}}}`,
+		},
+		{
+			name:     "secret",
+			state:    `{"environment_variables": [{"key": "SECRET", "value": null, "is_secret": true}]}`,
+			expected: `{"environment_variables": {"SECRET": {"value": null, "is_secret": true}}}`,
+		},
+		{
+			name:     "duplicate key",
+			state:    `{"environment_variables": [{"key": "ONE", "value": "1", "is_secret": false}, {"key": "ONE", "value": "2", "is_secret": true}]}`,
+			expected: `{"environment_variables": {"ONE": {"value": "2", "is_secret": true}}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testUpgradeDeploymentState(t, upgradeDeploymentEnvironmentVariablesToMap, test.state, test.expected)
+		})
+	}
+}
+
+func TestUpgradeDeploymentWorkerQueuesToMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    string
+		expected string
+	}{
+		{
+			name:     "missing",
+			state:    `{"name": "deployment"}`,
+			expected: `{"name": "deployment"}`,
+		},
+		{
+			name:     "null",
+			state:    `{"worker_queues": null}`,
+			expected: `{"worker_queues": null}`,
+		},
+		{
+			name:     "empty",
+			state:    `{"worker_queues": []}`,
+			expected: `{"worker_queues": {}}`,
+		},
+		{
+			name:     "queues",
+			state:    `{"worker_queues": [{"id": "a", "name": "default", "is_default": true, "max_worker_count": 10}, {"id": "b", "name": "large", "is_default": false, "max_worker_count": 2}]}`,
+			expected: `{"worker_queues": {"default": {"id": "a", "is_default": true, "max_worker_count": 10}, "large": {"id": "b", "is_default": false, "max_worker_count": 2}}}`,
+		},
+		{
+			name:     "duplicate name",
+			state:    `{"worker_queues": [{"id": "a", "name": "default", "is_default": true}, {"id": "b", "name": "default", "is_default": false}]}`,
+			expected: `{"worker_queues": {"default": {"id": "b", "is_default": false}}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testUpgradeDeploymentState(t, upgradeDeploymentWorkerQueuesToMap, test.state, test.expected)
+		})
+	}
+}
+
+func testUpgradeDeploymentState(t *testing.T, upgrade func(map[string]any), state string, expected string) {
+	t.Helper()
+
+	var upgraded, want map[string]any
+	if err := json.Unmarshal([]byte(state), &upgraded); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(expected), &want); err != nil {
+		t.Fatal(err)
+	}
+
+	upgrade(upgraded)
+
+	if !reflect.DeepEqual(upgraded, want) {
+		got, _ := json.Marshal(upgraded)
+		t.Fatalf("expected %s, got %s", expected, got)
+	}
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+// UpdateDeployment replaces the whole deployment, so resources that only own part of it
+// (environment variables, worker queues, ...) read the current deployment, merge their change
+// and write it back. The lock serializes those read-merge-writes within a single apply.
+var deploymentLocks sync.Map
+
+func lockDeployment(deploymentId string) func() {
+	value, _ := deploymentLocks.LoadOrStore(deploymentId, &sync.Mutex{})
+	mutex := value.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+// deploymentMergeAttempts bounds the retries of a change that was overwritten.
+const deploymentMergeAttempts = 3
+
+// mergeDeployment reads the Deployment, applies change to an update request built from it and
+// writes it back, leaving every other setting as it is. lockDeployment only serializes changes
+// made by this provider process, so another Terraform run, or the Astro UI, can write the
+// Deployment between the read and the write and drop the change. The Deployment is read back
+// and the change is retried until applied reports it's in place.
+func mergeDeployment(token string, organizationId string, deploymentId string, change func(*api.DeploymentUpdateRequest), applied func(*api.DeploymentResponse) bool) (*api.DeploymentResponse, error) {
+	unlock := lockDeployment(deploymentId)
+	defer unlock()
+
+	for attempt := 0; attempt < deploymentMergeAttempts; attempt++ {
+		deployment, err := api.GetDeployment(token, organizationId, deploymentId)
+		if err != nil {
+			return nil, err
+		}
+
+		updateRequest := createDeploymentUpdateRequestFromResponse(deployment)
+		change(updateRequest)
+
+		if _, err := api.UpdateDeployment(token, organizationId, deploymentId, updateRequest); err != nil {
+			return nil, err
+		}
+
+		deployment, err = api.GetDeployment(token, organizationId, deploymentId)
+		if err != nil {
+			return nil, err
+		}
+		if applied(deployment) {
+			return deployment, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Deployment %s was updated concurrently and the change was lost %d times", deploymentId, deploymentMergeAttempts)
+}
+
+// A runtime upgrade usually rolls out within a few minutes; the caps keep a stuck rollout from
+// hanging the apply.
+const (
+	deploymentHealthyTimeout      = 30 * time.Minute
+	deploymentUnhealthyTimeout    = 10 * time.Minute
+	deploymentHealthyPollInterval = 5 * time.Second
+)
+
+// waitForDeploymentHealthy polls the Deployment until it's healthy on the given Astro Runtime
+// version, or on any version when runtimeVersion is empty. A runtime upgrade rolls out the new
+// image, so the Deployment reports DEPLOYING, and possibly UNHEALTHY for a while, until it's done.
+// A hibernating Deployment can't become healthy, so it's returned as soon as it's on the version.
+func waitForDeploymentHealthy(ctx context.Context, token string, organizationId string, deployment *api.DeploymentResponse, runtimeVersion string) (*api.DeploymentResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, deploymentHealthyTimeout)
+	defer cancel()
+
+	var err error
+	var unhealthySince time.Time
+	for {
+		if runtimeVersion == "" || deployment.RuntimeVersion == runtimeVersion {
+			if deployment.Status == api.DeploymentStatusHealthy || deployment.Status == api.DeploymentStatusHibernating {
+				return deployment, nil
+			}
+		}
+		if deployment.Status != api.DeploymentStatusUnhealthy {
+			unhealthySince = time.Time{}
+		} else if unhealthySince.IsZero() {
+			unhealthySince = time.Now()
+		} else if time.Since(unhealthySince) > deploymentUnhealthyTimeout {
+			return nil, fmt.Errorf("Deployment %s has been in status %s for %s: %s", deployment.Id, deployment.Status, deploymentUnhealthyTimeout, deployment.StatusReason)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Deployment %s is in status %s on Astro Runtime %s after waiting for it to be healthy: %s", deployment.Id, deployment.Status, deployment.RuntimeVersion, ctx.Err())
+		case <-time.After(deploymentHealthyPollInterval):
+		}
+
+		deployment, err = api.GetDeployment(token, organizationId, deployment.Id)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read deployment, got error: %s", err)
+		}
+	}
+}
+
+func createEnvironmentVariableRequestFromResponse(envVars []api.EnvironmentVariableResponse) []api.EnvironmentVariableRequest {
+	var requests []api.EnvironmentVariableRequest = []api.EnvironmentVariableRequest{}
+	for _, value := range envVars {
+		request := api.EnvironmentVariableRequest{
+			IsSecret: value.IsSecret,
+			Key:      value.Key,
+		}
+		// Secret values aren't returned by the API, omitting them keeps the current value
+		if !value.IsSecret {
+			request.Value = value.Value
+		}
+		requests = append(requests, request)
+	}
+	return requests
+}
+
+// The default worker queue of a Deployment switched to the CELERY executor without worker_queues,
+// matching the queue the Astro UI creates.
+const (
+	defaultWorkerQueueName              = "default"
+	defaultWorkerQueueAstroMachine      = "A5"
+	defaultWorkerQueueMaxWorkerCount    = 10
+	defaultWorkerQueueMinWorkerCount    = 0
+	defaultWorkerQueueWorkerConcurrency = 5
+)
+
+// createDefaultWorkerQueueRequest returns the default queue a CELERY Deployment needs. It keeps the
+// ID and node pool of the Deployment's current default queue, so the queue is updated rather than
+// recreated.
+func createDefaultWorkerQueueRequest(deployment *api.DeploymentResponse, deploymentType string) api.WorkerQueue {
+	workerQueue := api.WorkerQueue{
+		IsDefault:         true,
+		MaxWorkerCount:    defaultWorkerQueueMaxWorkerCount,
+		MinWorkerCount:    defaultWorkerQueueMinWorkerCount,
+		Name:              defaultWorkerQueueName,
+		WorkerConcurrency: defaultWorkerQueueWorkerConcurrency,
+	}
+	nodePoolId := deployment.TaskPodNodePoolId
+	for _, value := range deployment.WorkerQueues {
+		if value.IsDefault {
+			workerQueue.Id = value.Id
+			workerQueue.Name = value.Name
+			if value.NodePoolId != "" {
+				nodePoolId = value.NodePoolId
+			}
+		}
+	}
+	if deploymentType == api.DeploymentTypeHybrid {
+		workerQueue.NodePoolId = nodePoolId
+	} else {
+		workerQueue.AstroMachine = defaultWorkerQueueAstroMachine
+	}
+	return workerQueue
+}
+
+// createWorkerQueueRequests drops the queue fields that don't apply to the Deployment's type and
+// executor. The API returns them, e.g. the pod size of an Astro machine, but rejects them on update.
+func createWorkerQueueRequests(workerQueues []api.WorkerQueue, deploymentType string, executor string) []api.WorkerQueue {
+	var requests []api.WorkerQueue
+	for _, value := range workerQueues {
+		if deploymentType == api.DeploymentTypeHybrid {
+			value.AstroMachine = ""
+		} else {
+			value.NodePoolId = ""
+		}
+		if executor != api.DeploymentExecutorKubernetes {
+			value.PodCpu = ""
+			value.PodMemory = ""
+		}
+		requests = append(requests, value)
+	}
+	return requests
+}
+
+// loadActiveHibernationOverride returns the Deployment's hibernation override unless it has
+// ended. The API rejects an override_until in the past, so an ended override isn't sent back.
+func loadActiveHibernationOverride(scalingSpec *api.DeploymentScalingSpec) *api.DeploymentHibernationOverride {
+	if scalingSpec == nil || scalingSpec.HibernationSpec == nil || scalingSpec.HibernationSpec.Override == nil {
+		return nil
+	}
+	if overrideExpired(scalingSpec.HibernationSpec.Override.OverrideUntil) {
+		return nil
+	}
+	return scalingSpec.HibernationSpec.Override
+}
+
+func createScalingSpecRequestFromResponse(scalingSpec *api.DeploymentScalingSpec) *api.DeploymentScalingSpec {
+	if scalingSpec == nil || scalingSpec.HibernationSpec == nil {
+		return scalingSpec
+	}
+	hibernationSpec := *scalingSpec.HibernationSpec
+	hibernationSpec.Override = loadActiveHibernationOverride(scalingSpec)
+	return &api.DeploymentScalingSpec{HibernationSpec: &hibernationSpec}
+}
+
+func createDeploymentUpdateRequestFromResponse(deployment *api.DeploymentResponse) *api.DeploymentUpdateRequest {
+	updateRequest := &api.DeploymentUpdateRequest{
+		ContactEmails:        deployment.ContactEmails,
+		DefaultTaskPodCpu:    deployment.DefaultTaskPodCpu,
+		DefaultTaskPodMemory: deployment.DefaultTaskPodMemory,
+		Description:          deployment.Description,
+		EnvironmentVariables: createEnvironmentVariableRequestFromResponse(deployment.EnvironmentVariables),
+		Executor:             deployment.Executor,
+		IsCicdEnforced:       deployment.IsCicdEnforced,
+		IsDagDeployEnabled:   deployment.IsDagDeployEnabled,
+		IsDevelopmentMode:    deployment.IsDevelopmentMode,
+		IsHighAvailability:   deployment.IsHighAvailability,
+		Name:                 deployment.Name,
+		ResourceQuotaCpu:     deployment.ResourceQuotaCpu,
+		ResourceQuotaMemory:  deployment.ResourceQuotaMemory,
+		ScalingSpec:          createScalingSpecRequestFromResponse(deployment.ScalingSpec),
+		SchedulerSize:        deployment.SchedulerSize,
+		TaskPodNodePoolId:    deployment.TaskPodNodePoolId,
+		Type:                 deployment.Type,
+		WorkerQueues:         createWorkerQueueRequests(deployment.WorkerQueues, deployment.Type, deployment.Executor),
+		WorkloadIdentity:     deployment.WorkloadIdentity,
+		WorkspaceId:          deployment.WorkspaceId,
+	}
+
+	if deployment.Type == api.DeploymentTypeHybrid {
+		updateRequest.Scheduler = &api.SchedulerRequest{
+			Au:       deployment.SchedulerAu,
+			Replicas: deployment.SchedulerReplicas,
+		}
+	}
+	return updateRequest
+}
@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &DeploymentWorkerQueueResource{}
+var _ resource.ResourceWithImportState = &DeploymentWorkerQueueResource{}
+var _ resource.ResourceWithValidateConfig = &DeploymentWorkerQueueResource{}
+
+func NewDeploymentWorkerQueueResource() resource.Resource {
+	return &DeploymentWorkerQueueResource{}
+}
+
+type DeploymentWorkerQueueResource struct {
+	token          string
+	organizationId string
+}
+
+type DeploymentWorkerQueueResourceModel struct {
+	AstroMachine      types.String `tfsdk:"astro_machine"`
+	DeploymentId      types.String `tfsdk:"deployment_id"`
+	Id                types.String `tfsdk:"id"`
+	IsDefault         types.Bool   `tfsdk:"is_default"`
+	MaxWorkerCount    types.Int64  `tfsdk:"max_worker_count"`
+	MinWorkerCount    types.Int64  `tfsdk:"min_worker_count"`
+	Name              types.String `tfsdk:"name"`
+	NodePoolId        types.String `tfsdk:"node_pool_id"`
+	WorkerConcurrency types.Int64  `tfsdk:"worker_concurrency"`
+}
+
+func (r *DeploymentWorkerQueueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_worker_queue"
+}
+
+func (r *DeploymentWorkerQueueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A single worker queue on a Deployment using the `CELERY` executor. The queue is merged into the Deployment's existing worker queues, so queues can be owned by different Terraform configurations. Changes to the same Deployment are serialized within one Terraform run only; the provider re-reads the Deployment after each change and retries it if another run overwrote it. Don't also declare the same queue in `worker_queues` on `astronomer_deployment`.",
+
+		Attributes: map[string]schema.Attribute{
+			"astro_machine": schema.StringAttribute{
+				MarkdownDescription: "The Astro machine type of the queue's workers, e.g. `A5`. Exactly one of `astro_machine` and `node_pool_id` must be set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("node_pool_id")),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Deployment the worker queue belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The worker queue's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the worker queue is the Deployment's default queue.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_worker_count": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of workers in the queue.",
+				Required:            true,
+			},
+			"min_worker_count": schema.Int64Attribute{
+				MarkdownDescription: "The minimum number of workers in the queue.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The worker queue's name. Tasks are routed to the queue with the `queue` argument.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node_pool_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the node pool the queue's workers run on, for `HYBRID` Deployments. Exactly one of `astro_machine` and `node_pool_id` must be set.",
+				Optional:            true,
+			},
+			"worker_concurrency": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of tasks each worker runs at once.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *DeploymentWorkerQueueResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var isDefault types.Bool
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_default"), &isDefault)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+
+	if isDefault.ValueBool() && name.ValueString() != "default" {
+		resp.Diagnostics.AddAttributeError(path.Root("is_default"), "Validation Error", fmt.Sprintf("The default worker queue must be named default, got %s.", name.ValueString()))
+	}
+}
+
+func (r *DeploymentWorkerQueueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+func findWorkerQueue(workerQueues []api.WorkerQueue, name string) int {
+	return slices.IndexFunc(workerQueues, func(workerQueue api.WorkerQueue) bool { return workerQueue.Name == name })
+}
+
+// mergeWorkerQueue applies change to the Deployment's worker queues, leaving every other setting
+// and queue as it is.
+func (r *DeploymentWorkerQueueResource) mergeWorkerQueue(deploymentId string, change func([]api.WorkerQueue) []api.WorkerQueue, applied func([]api.WorkerQueue) bool) (*api.DeploymentResponse, error) {
+	return mergeDeployment(r.token, r.organizationId, deploymentId, func(updateRequest *api.DeploymentUpdateRequest) {
+		updateRequest.WorkerQueues = change(updateRequest.WorkerQueues)
+	}, func(deployment *api.DeploymentResponse) bool {
+		return applied(deployment.WorkerQueues)
+	})
+}
+
+func (r *DeploymentWorkerQueueResource) putWorkerQueue(data DeploymentWorkerQueueResourceModel) (*api.DeploymentResponse, error) {
+	workerQueue := api.WorkerQueue{
+		AstroMachine:      data.AstroMachine.ValueString(),
+		IsDefault:         data.IsDefault.ValueBool(),
+		MaxWorkerCount:    int(data.MaxWorkerCount.ValueInt64()),
+		MinWorkerCount:    int(data.MinWorkerCount.ValueInt64()),
+		Name:              data.Name.ValueString(),
+		NodePoolId:        data.NodePoolId.ValueString(),
+		WorkerConcurrency: int(data.WorkerConcurrency.ValueInt64()),
+	}
+
+	return r.mergeWorkerQueue(data.DeploymentId.ValueString(), func(workerQueues []api.WorkerQueue) []api.WorkerQueue {
+		idx := findWorkerQueue(workerQueues, workerQueue.Name)
+		if idx == -1 {
+			return append(workerQueues, workerQueue)
+		}
+		// Keep the queue's id so the API updates the queue instead of recreating it
+		workerQueue.Id = workerQueues[idx].Id
+		workerQueues[idx] = workerQueue
+		return workerQueues
+	}, func(workerQueues []api.WorkerQueue) bool {
+		idx := findWorkerQueue(workerQueues, workerQueue.Name)
+		return idx != -1 &&
+			workerQueues[idx].MaxWorkerCount == workerQueue.MaxWorkerCount &&
+			workerQueues[idx].MinWorkerCount == workerQueue.MinWorkerCount &&
+			workerQueues[idx].WorkerConcurrency == workerQueue.WorkerConcurrency
+	})
+}
+
+func loadDeploymentWorkerQueueFromResponse(data *DeploymentWorkerQueueResourceModel, workerQueue api.WorkerQueue) {
+	// Queues on Astro-hosted clusters can report a node pool too, only the configured one is loaded.
+	// Imported queues have neither, HYBRID queues are the ones without an Astro machine.
+	if data.AstroMachine.IsNull() && data.NodePoolId.IsNull() {
+		if workerQueue.AstroMachine != "" {
+			data.AstroMachine = types.StringValue(workerQueue.AstroMachine)
+		} else {
+			data.NodePoolId = types.StringValue(workerQueue.NodePoolId)
+		}
+	} else if !data.AstroMachine.IsNull() {
+		data.AstroMachine = types.StringValue(workerQueue.AstroMachine)
+	} else {
+		data.NodePoolId = types.StringValue(workerQueue.NodePoolId)
+	}
+	data.Id = types.StringValue(workerQueue.Id)
+	data.IsDefault = types.BoolValue(workerQueue.IsDefault)
+	data.MaxWorkerCount = types.Int64Value(int64(workerQueue.MaxWorkerCount))
+	data.MinWorkerCount = types.Int64Value(int64(workerQueue.MinWorkerCount))
+	data.Name = types.StringValue(workerQueue.Name)
+	data.WorkerConcurrency = types.Int64Value(int64(workerQueue.WorkerConcurrency))
+}
+
+func (r *DeploymentWorkerQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentWorkerQueueResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.putWorkerQueue(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create worker queue, got error: %s", err))
+		return
+	}
+
+	idx := findWorkerQueue(deployment.WorkerQueues, data.Name.ValueString())
+	if idx == -1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Worker queue %s was not found on Deployment %s after creation", data.Name.ValueString(), data.DeploymentId.ValueString()))
+		return
+	}
+	loadDeploymentWorkerQueueFromResponse(&data, deployment.WorkerQueues[idx])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentWorkerQueueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeploymentWorkerQueueResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := api.GetDeployment(r.token, r.organizationId, data.DeploymentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read worker queue, got error: %s", err))
+		return
+	}
+
+	idx := findWorkerQueue(deployment.WorkerQueues, data.Name.ValueString())
+	if idx == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	loadDeploymentWorkerQueueFromResponse(&data, deployment.WorkerQueues[idx])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentWorkerQueueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeploymentWorkerQueueResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.putWorkerQueue(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update worker queue, got error: %s", err))
+		return
+	}
+
+	idx := findWorkerQueue(deployment.WorkerQueues, data.Name.ValueString())
+	if idx == -1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Worker queue %s was not found on Deployment %s after update", data.Name.ValueString(), data.DeploymentId.ValueString()))
+		return
+	}
+	loadDeploymentWorkerQueueFromResponse(&data, deployment.WorkerQueues[idx])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentWorkerQueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeploymentWorkerQueueResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.mergeWorkerQueue(data.DeploymentId.ValueString(), func(workerQueues []api.WorkerQueue) []api.WorkerQueue {
+		return slices.DeleteFunc(workerQueues, func(workerQueue api.WorkerQueue) bool { return workerQueue.Name == data.Name.ValueString() })
+	}, func(workerQueues []api.WorkerQueue) bool {
+		return findWorkerQueue(workerQueues, data.Name.ValueString()) == -1
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete worker queue, got error: %s", err))
+		return
+	}
+}
+
+func (r *DeploymentWorkerQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	deploymentId, name, found := strings.Cut(req.ID, "/")
+	if !found || deploymentId == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: deployment_id/queue_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
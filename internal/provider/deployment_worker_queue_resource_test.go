@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDeploymentWorkerQueueResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentWorkerQueueResourceConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_worker_queue.test", "name", "tf-acc"),
+					resource.TestCheckResourceAttr("astronomer_deployment_worker_queue.test", "max_worker_count", "2"),
+					resource.TestCheckResourceAttr("astronomer_deployment_worker_queue.test", "is_default", "false"),
+					resource.TestCheckResourceAttrSet("astronomer_deployment_worker_queue.test", "id"),
+					resource.TestCheckResourceAttr("astronomer_deployment.test", "worker_queues.%", "1"),
+				),
+			},
+			{
+				ResourceName:      "astronomer_deployment_worker_queue.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["astronomer_deployment_worker_queue.test"]
+					return rs.Primary.Attributes["deployment_id"] + "/" + rs.Primary.Attributes["name"], nil
+				},
+			},
+			{
+				Config: testAccDeploymentWorkerQueueResourceConfig(3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_deployment_worker_queue.test", "max_worker_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeploymentWorkerQueueResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(testAccDeploymentWorkerQueueResourceConfig(2), "\tastro_machine = \"A5\"\n", "", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`No attribute specified when one \(and only one\) of`),
+			},
+			{
+				Config:      strings.Replace(testAccDeploymentWorkerQueueResourceConfig(2), "\tastro_machine = \"A5\"\n", "\tastro_machine = \"A5\"\n\tnode_pool_id = \"pool\"\n", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`2 attributes specified when one \(and only one\) of`),
+			},
+			{
+				Config:      strings.Replace(testAccDeploymentWorkerQueueResourceConfig(2), "\tname = \"tf-acc\"\n", "\tname = \"tf-acc\"\n\tis_default = true\n", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The default worker queue must be named default, got tf-acc`),
+			},
+		},
+	})
+}
+
+func testAccDeploymentWorkerQueueResourceConfig(maxWorkerCount int) string {
+	return testAccDeploymentConfig("TestWorkerQueueDeployment", "") + fmt.Sprintf(`
+resource "astronomer_deployment_worker_queue" "test" {
+	deployment_id = astronomer_deployment.test.id
+	name = "tf-acc"
+	astro_machine = "A5"
+	max_worker_count = %[1]d
+	min_worker_count = 0
+	worker_concurrency = 1
+}
+`, maxWorkerCount)
+}
@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &IdentityProviderResource{}
+var _ resource.ResourceWithImportState = &IdentityProviderResource{}
+var _ resource.ResourceWithValidateConfig = &IdentityProviderResource{}
+
+func NewIdentityProviderResource() resource.Resource {
+	return &IdentityProviderResource{}
+}
+
+type IdentityProviderResource struct {
+	token          string
+	organizationId string
+}
+
+type IdentityProviderResourceModel struct {
+	AcsUrl            types.String         `tfsdk:"acs_url"`
+	CreatedAt         types.String         `tfsdk:"created_at"`
+	EntityId          types.String         `tfsdk:"entity_id"`
+	Id                types.String         `tfsdk:"id"`
+	ManagedDomainIds  []types.String       `tfsdk:"managed_domain_ids"`
+	OidcConnection    *OidcConnectionModel `tfsdk:"oidc_connection"`
+	SamlConnection    *SamlConnectionModel `tfsdk:"saml_connection"`
+	Status            types.String         `tfsdk:"status"`
+	Type              types.String         `tfsdk:"type"`
+	UpdatedAt         types.String         `tfsdk:"updated_at"`
+	VerificationToken types.String         `tfsdk:"verification_token"`
+}
+
+type OidcConnectionModel struct {
+	ClientId            types.String `tfsdk:"client_id"`
+	ClientSecret        types.String `tfsdk:"client_secret"`
+	ClientSecretVersion types.Int64  `tfsdk:"client_secret_version"`
+	DiscoveryUrl        types.String `tfsdk:"discovery_url"`
+}
+
+type SamlConnectionModel struct {
+	AuthRequestUrl types.String `tfsdk:"auth_request_url"`
+	SigningCert    types.String `tfsdk:"signing_cert"`
+}
+
+func (r *IdentityProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_provider"
+}
+
+func (r *IdentityProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A SAML or OIDC single sign-on connection for the organization's managed domains.",
+
+		Attributes: map[string]schema.Attribute{
+			"acs_url": schema.StringAttribute{
+				MarkdownDescription: "The Assertion Consumer Service URL to configure in the identity provider. For SAML connections only.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this identity provider was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entity_id": schema.StringAttribute{
+				MarkdownDescription: "The service provider entity ID to configure in the identity provider. For SAML connections only.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identity provider's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"managed_domain_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the managed domains whose users sign in through this identity provider.",
+				Required:            true,
+			},
+			"oidc_connection": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "The OIDC client ID.",
+						Required:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "The OIDC client secret. Write-only, bump `client_secret_version` to send a new value.",
+						Required:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"client_secret_version": schema.Int64Attribute{
+						MarkdownDescription: "Change this to send `client_secret` to the API again, e.g. after rotating it.",
+						Optional:            true,
+					},
+					"discovery_url": schema.StringAttribute{
+						MarkdownDescription: "The OIDC discovery URL of the identity provider.",
+						Required:            true,
+					},
+				},
+				MarkdownDescription: "The OIDC connection settings. Required when `type` is `OIDC`. `client_secret` is a write-only attribute, which requires Terraform 1.11 or later: it's never stored in the Terraform state, and changes, including ones made outside of Terraform, are only sent when `client_secret_version` changes.",
+				Optional:            true,
+			},
+			"saml_connection": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"auth_request_url": schema.StringAttribute{
+						MarkdownDescription: "The identity provider's single sign-on URL.",
+						Required:            true,
+					},
+					"signing_cert": schema.StringAttribute{
+						MarkdownDescription: "The identity provider's PEM encoded signing certificate.",
+						Required:            true,
+					},
+				},
+				MarkdownDescription: "The SAML connection settings. Required when `type` is `SAML`.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Whether the identity provider is `ACTIVE` or `INACTIVE`.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The identity provider's type, either `SAML` or `OIDC`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.IdentityProviderTypeOidc, api.IdentityProviderTypeSaml),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the identity provider was updated.",
+				Computed:            true,
+			},
+			"verification_token": schema.StringAttribute{
+				MarkdownDescription: "The token used to verify the connection with the identity provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IdentityProviderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var identityProviderType types.String
+	var oidcConnection, samlConnection types.Object
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &identityProviderType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oidc_connection"), &oidcConnection)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("saml_connection"), &samlConnection)...)
+
+	if resp.Diagnostics.HasError() || identityProviderType.IsUnknown() {
+		return
+	}
+
+	switch identityProviderType.ValueString() {
+	case api.IdentityProviderTypeSaml:
+		if samlConnection.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("saml_connection"), "Validation Error", "SAML identity providers require a saml_connection")
+		}
+		if !oidcConnection.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("oidc_connection"), "Validation Error", "SAML identity providers can't set an oidc_connection")
+		}
+	case api.IdentityProviderTypeOidc:
+		if oidcConnection.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("oidc_connection"), "Validation Error", "OIDC identity providers require an oidc_connection")
+		}
+		if !samlConnection.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("saml_connection"), "Validation Error", "OIDC identity providers can't set a saml_connection")
+		}
+	}
+}
+
+func (r *IdentityProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+// loadIdentityProviderSecretsFromConfig copies the write-only client secret, which is null in the
+// plan, from the configuration.
+func loadIdentityProviderSecretsFromConfig(data *IdentityProviderResourceModel, config IdentityProviderResourceModel) {
+	if data.OidcConnection == nil || config.OidcConnection == nil {
+		return
+	}
+	data.OidcConnection.ClientSecret = config.OidcConnection.ClientSecret
+}
+
+func createOidcConnectionRequestFromTFState(data IdentityProviderResourceModel) *api.OidcConnection {
+	if data.OidcConnection == nil {
+		return nil
+	}
+	return &api.OidcConnection{
+		ClientId:     data.OidcConnection.ClientId.ValueString(),
+		ClientSecret: data.OidcConnection.ClientSecret.ValueString(),
+		DiscoveryUrl: data.OidcConnection.DiscoveryUrl.ValueString(),
+	}
+}
+
+func createSamlConnectionRequestFromTFState(data IdentityProviderResourceModel) *api.SamlConnection {
+	if data.SamlConnection == nil {
+		return nil
+	}
+	return &api.SamlConnection{
+		AuthRequestUrl: data.SamlConnection.AuthRequestUrl.ValueString(),
+		SigningCert:    data.SamlConnection.SigningCert.ValueString(),
+	}
+}
+
+func loadIdentityProviderResourceFromResponse(data *IdentityProviderResourceModel, identityProvider *api.IdentityProviderResponse) {
+	var managedDomainIds []types.String = []types.String{}
+	for _, domain := range identityProvider.ManagedDomains {
+		managedDomainIds = append(managedDomainIds, types.StringValue(domain.Id))
+	}
+
+	data.AcsUrl = types.StringValue(identityProvider.AcsUrl)
+	data.CreatedAt = types.StringValue(identityProvider.CreatedAt)
+	data.EntityId = types.StringValue(identityProvider.EntityId)
+	data.Id = types.StringValue(identityProvider.Id)
+	data.ManagedDomainIds = managedDomainIds
+	data.Status = types.StringValue(identityProvider.Status)
+	data.Type = types.StringValue(identityProvider.Type)
+	data.UpdatedAt = types.StringValue(identityProvider.UpdatedAt)
+	data.VerificationToken = types.StringValue(identityProvider.VerificationToken)
+
+	if identityProvider.OidcConnection != nil {
+		// The secret is write-only, the API doesn't return it either. Only its version is kept.
+		clientSecretVersion := types.Int64Null()
+		if data.OidcConnection != nil {
+			clientSecretVersion = data.OidcConnection.ClientSecretVersion
+		}
+		data.OidcConnection = &OidcConnectionModel{
+			ClientId:            types.StringValue(identityProvider.OidcConnection.ClientId),
+			ClientSecret:        types.StringNull(),
+			ClientSecretVersion: clientSecretVersion,
+			DiscoveryUrl:        types.StringValue(identityProvider.OidcConnection.DiscoveryUrl),
+		}
+	}
+
+	if identityProvider.SamlConnection != nil {
+		data.SamlConnection = &SamlConnectionModel{
+			AuthRequestUrl: types.StringValue(identityProvider.SamlConnection.AuthRequestUrl),
+			SigningCert:    types.StringValue(identityProvider.SamlConnection.SigningCert),
+		}
+	}
+}
+
+func (r *IdentityProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdentityProviderResourceModel
+	var config IdentityProviderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loadIdentityProviderSecretsFromConfig(&data, config)
+
+	createRequest := &api.IdentityProviderCreateRequest{
+		ManagedDomainIds: createStringListFromTFState(data.ManagedDomainIds),
+		OidcConnection:   createOidcConnectionRequestFromTFState(data),
+		SamlConnection:   createSamlConnectionRequestFromTFState(data),
+		Type:             data.Type.ValueString(),
+	}
+
+	identityProvider, err := api.CreateIdentityProvider(r.token, r.organizationId, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create identity provider, got error: %s", err))
+		return
+	}
+
+	loadIdentityProviderResourceFromResponse(&data, identityProvider)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdentityProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdentityProviderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityProvider, err := api.GetIdentityProvider(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read identity provider, got error: %s", err))
+		return
+	}
+
+	loadIdentityProviderResourceFromResponse(&data, identityProvider)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdentityProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IdentityProviderResourceModel
+	var config IdentityProviderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loadIdentityProviderSecretsFromConfig(&data, config)
+
+	updateRequest := &api.IdentityProviderUpdateRequest{
+		ManagedDomainIds: createStringListFromTFState(data.ManagedDomainIds),
+		OidcConnection:   createOidcConnectionRequestFromTFState(data),
+		SamlConnection:   createSamlConnectionRequestFromTFState(data),
+	}
+
+	identityProvider, err := api.UpdateIdentityProvider(r.token, r.organizationId, data.Id.ValueString(), updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update identity provider, got error: %s", err))
+		return
+	}
+
+	loadIdentityProviderResourceFromResponse(&data, identityProvider)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdentityProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IdentityProviderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteIdentityProvider(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete identity provider, got error: %s", err))
+		return
+	}
+}
+
+func (r *IdentityProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
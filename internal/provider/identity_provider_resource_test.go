@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccIdentityProviderResource(t *testing.T) {
+	signingCert := testAccSigningCert(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderResourceConfig("https://tfacc.example.com/sso/saml", signingCert),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_identity_provider.test", "type", "SAML"),
+					resource.TestCheckResourceAttr("astronomer_identity_provider.test", "managed_domain_ids.#", "1"),
+					resource.TestCheckResourceAttr("astronomer_identity_provider.test", "saml_connection.auth_request_url", "https://tfacc.example.com/sso/saml"),
+					resource.TestCheckResourceAttrSet("astronomer_identity_provider.test", "acs_url"),
+					resource.TestCheckResourceAttrSet("astronomer_identity_provider.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "astronomer_identity_provider.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
+			{
+				Config: testAccIdentityProviderResourceConfig("https://tfacc.example.com/sso/saml/v2", signingCert),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_identity_provider.test", "saml_connection.auth_request_url", "https://tfacc.example.com/sso/saml/v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityProviderResourceWriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderResourceOidcConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_identity_provider.test", "type", "OIDC"),
+					resource.TestCheckResourceAttr("astronomer_identity_provider.test", "oidc_connection.client_id", "tfacc"),
+					resource.TestCheckNoResourceAttr("astronomer_identity_provider.test", "oidc_connection.client_secret"),
+					resource.TestCheckResourceAttr("astronomer_identity_provider.test", "oidc_connection.client_secret_version", "1"),
+				),
+			},
+			{
+				Config: testAccIdentityProviderResourceOidcConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("astronomer_identity_provider.test", "oidc_connection.client_secret"),
+					resource.TestCheckResourceAttr("astronomer_identity_provider.test", "oidc_connection.client_secret_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityProviderResourceValidation(t *testing.T) {
+	config := testAccIdentityProviderResourceConfig("https://tfacc.example.com/sso/saml", testAccSigningCert(t))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(config, `type = "SAML"`, `type = "LDAP"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config:      strings.Replace(config, `type = "SAML"`, `type = "OIDC"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`OIDC identity providers require an oidc_connection`),
+			},
+		},
+	})
+}
+
+// testAccSigningCert returns a self-signed PEM encoded certificate for SAML connections.
+func testAccSigningCert(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "tfacc.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}))
+}
+
+func testAccIdentityProviderResourceConfig(authRequestUrl string, signingCert string) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_managed_domain" "test" {
+	name = "tfacc-sso.gkconsulting.dev"
+	enforced_logins = ["SSO"]
+}
+
+resource "astronomer_identity_provider" "test" {
+	type = "SAML"
+	managed_domain_ids = [astronomer_managed_domain.test.id]
+	saml_connection = {
+		auth_request_url = %[2]q
+		signing_cert = <<EOT
+%[3]sEOT
+	}
+}
+`, orgId, authRequestUrl, signingCert)
+}
+
+func testAccIdentityProviderResourceOidcConfig(clientSecretVersion int) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_managed_domain" "test" {
+	name = "tfacc-oidc.gkconsulting.dev"
+	enforced_logins = ["SSO"]
+}
+
+resource "astronomer_identity_provider" "test" {
+	type = "OIDC"
+	managed_domain_ids = [astronomer_managed_domain.test.id]
+	oidc_connection = {
+		client_id = "tfacc"
+		client_secret = "CLIENT_SECRET_%[2]d"
+		client_secret_version = %[2]d
+		discovery_url = "https://tfacc.example.com/.well-known/openid-configuration"
+	}
+}
+`, orgId, clientSecretVersion)
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &ManagedDomainResource{}
+var _ resource.ResourceWithImportState = &ManagedDomainResource{}
+
+func NewManagedDomainResource() resource.Resource {
+	return &ManagedDomainResource{}
+}
+
+type ManagedDomainResource struct {
+	token          string
+	organizationId string
+}
+
+type ManagedDomainResourceModel struct {
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	EnforcedLogins    []types.String `tfsdk:"enforced_logins"`
+	Id                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Status            types.String   `tfsdk:"status"`
+	UpdatedAt         types.String   `tfsdk:"updated_at"`
+	VerificationToken types.String   `tfsdk:"verification_token"`
+}
+
+func (r *ManagedDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_domain"
+}
+
+func (r *ManagedDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A domain owned by the organization. Users with an email address on a verified managed domain can be restricted to the enforced login methods.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this managed domain was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enforced_logins": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The login methods users on this domain must use. One or more of `PASSWORD`, `GITHUB`, `GOOGLE` and `SSO`.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(api.EnforcedLoginGithub, api.EnforcedLoginGoogle, api.EnforcedLoginPassword, api.EnforcedLoginSso)),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The managed domain's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The domain name, e.g. `example.com`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Whether the domain is `PENDING` verification or `VERIFIED`.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the managed domain was updated.",
+				Computed:            true,
+			},
+			"verification_token": schema.StringAttribute{
+				MarkdownDescription: "The token to publish as a DNS TXT record on the domain to verify ownership.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ManagedDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+func loadManagedDomainResourceFromResponse(data *ManagedDomainResourceModel, domain *api.ManagedDomainResponse) {
+	data.CreatedAt = types.StringValue(domain.CreatedAt)
+	data.EnforcedLogins = loadEnforcedLoginsFromValues(domain.EnforcedLogins)
+	data.Id = types.StringValue(domain.Id)
+	data.Name = types.StringValue(domain.Name)
+	data.Status = types.StringValue(domain.Status)
+	data.UpdatedAt = types.StringValue(domain.UpdatedAt)
+	data.VerificationToken = types.StringValue(domain.VerificationToken)
+}
+
+func (r *ManagedDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ManagedDomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createRequest := &api.ManagedDomainCreateRequest{
+		EnforcedLogins: createStringListFromTFState(data.EnforcedLogins),
+		Name:           data.Name.ValueString(),
+	}
+
+	domain, err := api.CreateManagedDomain(r.token, r.organizationId, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create managed domain, got error: %s", err))
+		return
+	}
+
+	loadManagedDomainResourceFromResponse(&data, domain)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ManagedDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ManagedDomainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := api.GetManagedDomain(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read managed domain, got error: %s", err))
+		return
+	}
+
+	loadManagedDomainResourceFromResponse(&data, domain)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ManagedDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ManagedDomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateRequest := &api.ManagedDomainUpdateRequest{
+		EnforcedLogins: createStringListFromTFState(data.EnforcedLogins),
+	}
+
+	domain, err := api.UpdateManagedDomain(r.token, r.organizationId, data.Id.ValueString(), updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update managed domain, got error: %s", err))
+		return
+	}
+
+	loadManagedDomainResourceFromResponse(&data, domain)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ManagedDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ManagedDomainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteManagedDomain(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete managed domain, got error: %s", err))
+		return
+	}
+}
+
+func (r *ManagedDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccManagedDomainResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedDomainResourceConfig("PASSWORD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_managed_domain.test", "name", "tfacc.gkconsulting.dev"),
+					resource.TestCheckTypeSetElemAttr("astronomer_managed_domain.test", "enforced_logins.*", "PASSWORD"),
+					resource.TestCheckResourceAttr("astronomer_managed_domain.test", "status", "PENDING"),
+					resource.TestCheckResourceAttrSet("astronomer_managed_domain.test", "verification_token"),
+				),
+			},
+			{
+				ResourceName:      "astronomer_managed_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccManagedDomainResourceConfig("GOOGLE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("astronomer_managed_domain.test", "enforced_logins.*", "GOOGLE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccManagedDomainResourceConfig(enforcedLogin string) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_managed_domain" "test" {
+	name = "tfacc.gkconsulting.dev"
+	enforced_logins = [%[2]q]
+}
+`, orgId, enforcedLogin)
+}
@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &NotificationChannelResource{}
+var _ resource.ResourceWithImportState = &NotificationChannelResource{}
+
+func NewNotificationChannelResource() resource.Resource {
+	return &NotificationChannelResource{}
+}
+
+type NotificationChannelResource struct {
+	token          string
+	organizationId string
+}
+
+type NotificationChannelResourceModel struct {
+	CreatedAt  types.String                        `tfsdk:"created_at"`
+	Definition *NotificationChannelDefinitionModel `tfsdk:"definition"`
+	EntityId   types.String                        `tfsdk:"entity_id"`
+	EntityType types.String                        `tfsdk:"entity_type"`
+	Id         types.String                        `tfsdk:"id"`
+	IsShared   types.Bool                          `tfsdk:"is_shared"`
+	Name       types.String                        `tfsdk:"name"`
+	Type       types.String                        `tfsdk:"type"`
+	UpdatedAt  types.String                        `tfsdk:"updated_at"`
+}
+
+type NotificationChannelDefinitionModel struct {
+	ApiKey                    types.String   `tfsdk:"api_key"`
+	ApiKeyVersion             types.Int64    `tfsdk:"api_key_version"`
+	DagId                     types.String   `tfsdk:"dag_id"`
+	DeploymentApiToken        types.String   `tfsdk:"deployment_api_token"`
+	DeploymentApiTokenVersion types.Int64    `tfsdk:"deployment_api_token_version"`
+	DeploymentId              types.String   `tfsdk:"deployment_id"`
+	IntegrationKey            types.String   `tfsdk:"integration_key"`
+	IntegrationKeyVersion     types.Int64    `tfsdk:"integration_key_version"`
+	Recipients                []types.String `tfsdk:"recipients"`
+	WebhookUrl                types.String   `tfsdk:"webhook_url"`
+	WebhookUrlVersion         types.Int64    `tfsdk:"webhook_url_version"`
+}
+
+func (r *NotificationChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+func (r *NotificationChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A channel that alerts are sent to: email, Slack, PagerDuty, Opsgenie or a DAG trigger.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this notification channel was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"definition": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						MarkdownDescription: "The Opsgenie API key. Required for `OPSGENIE` channels. Write-only, bump `api_key_version` to send a new value.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"api_key_version": schema.Int64Attribute{
+						MarkdownDescription: "Change this to send `api_key` to the API again, e.g. after rotating it.",
+						Optional:            true,
+					},
+					"dag_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the DAG to trigger. Required for `DAG_TRIGGER` channels.",
+						Optional:            true,
+					},
+					"deployment_api_token": schema.StringAttribute{
+						MarkdownDescription: "An API token of the Deployment the DAG runs in. Required for `DAG_TRIGGER` channels. Write-only, bump `deployment_api_token_version` to send a new value.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"deployment_api_token_version": schema.Int64Attribute{
+						MarkdownDescription: "Change this to send `deployment_api_token` to the API again, e.g. after rotating it.",
+						Optional:            true,
+					},
+					"deployment_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the Deployment the DAG runs in. Required for `DAG_TRIGGER` channels.",
+						Optional:            true,
+					},
+					"integration_key": schema.StringAttribute{
+						MarkdownDescription: "The PagerDuty integration key. Required for `PAGERDUTY` channels. Write-only, bump `integration_key_version` to send a new value.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"integration_key_version": schema.Int64Attribute{
+						MarkdownDescription: "Change this to send `integration_key` to the API again, e.g. after rotating it.",
+						Optional:            true,
+					},
+					"recipients": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The email addresses to notify. Required for `EMAIL` channels.",
+						Optional:            true,
+					},
+					"webhook_url": schema.StringAttribute{
+						MarkdownDescription: "The Slack incoming webhook URL. Required for `SLACK` channels. Write-only, bump `webhook_url_version` to send a new value.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"webhook_url_version": schema.Int64Attribute{
+						MarkdownDescription: "Change this to send `webhook_url` to the API again, e.g. after rotating it.",
+						Optional:            true,
+					},
+				},
+				MarkdownDescription: "The channel's settings, only the ones of the channel's `type` apply. `api_key`, `deployment_api_token`, `integration_key` and `webhook_url` are write-only attributes, which require Terraform 1.11 or later: they're never stored in the Terraform state, and changes, including ones made outside of Terraform, are only sent when the matching `*_version` attribute changes.",
+				Required:            true,
+			},
+			"entity_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Deployment, workspace or organization the channel belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_type": schema.StringAttribute{
+				MarkdownDescription: "The type of entity the channel belongs to. One of `DEPLOYMENT`, `WORKSPACE` or `ORGANIZATION`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.NotificationChannelEntityTypeDeployment, api.NotificationChannelEntityTypeOrganization, api.NotificationChannelEntityTypeWorkspace),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The notification channel's identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_shared": schema.BoolAttribute{
+				MarkdownDescription: "Whether the channel can be used by alerts of other Deployments in the workspace or organization. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The notification channel's name.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The notification channel's type. One of `EMAIL`, `SLACK`, `PAGERDUTY`, `OPSGENIE` or `DAG_TRIGGER`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.NotificationChannelTypeDagTrigger, api.NotificationChannelTypeEmail, api.NotificationChannelTypeOpsgenie, api.NotificationChannelTypePagerDuty, api.NotificationChannelTypeSlack),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the notification channel was updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *NotificationChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+func validateNotificationChannelDefinition(data NotificationChannelResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.Definition == nil {
+		return diags
+	}
+	definition := data.Definition
+	required := func(name string, value types.String) {
+		if value.ValueString() == "" {
+			diags.AddError("Validation Error", fmt.Sprintf("%s notification channels require definition.%s", data.Type.ValueString(), name))
+		}
+	}
+
+	switch data.Type.ValueString() {
+	case api.NotificationChannelTypeDagTrigger:
+		required("dag_id", definition.DagId)
+		required("deployment_api_token", definition.DeploymentApiToken)
+		required("deployment_id", definition.DeploymentId)
+	case api.NotificationChannelTypeEmail:
+		if len(definition.Recipients) == 0 {
+			diags.AddError("Validation Error", "EMAIL notification channels require at least one definition.recipients address")
+		}
+	case api.NotificationChannelTypeOpsgenie:
+		required("api_key", definition.ApiKey)
+	case api.NotificationChannelTypePagerDuty:
+		required("integration_key", definition.IntegrationKey)
+	case api.NotificationChannelTypeSlack:
+		required("webhook_url", definition.WebhookUrl)
+	}
+	return diags
+}
+
+// loadNotificationChannelSecretsFromConfig copies the write-only secrets, which are null in the
+// plan, from the configuration.
+func loadNotificationChannelSecretsFromConfig(data *NotificationChannelResourceModel, config NotificationChannelResourceModel) {
+	if data.Definition == nil || config.Definition == nil {
+		return
+	}
+	data.Definition.ApiKey = config.Definition.ApiKey
+	data.Definition.DeploymentApiToken = config.Definition.DeploymentApiToken
+	data.Definition.IntegrationKey = config.Definition.IntegrationKey
+	data.Definition.WebhookUrl = config.Definition.WebhookUrl
+}
+
+func createNotificationChannelDefinitionFromTFState(data NotificationChannelResourceModel) api.NotificationChannelDefinition {
+	if data.Definition == nil {
+		return api.NotificationChannelDefinition{}
+	}
+	var recipients []string
+	if data.Definition.Recipients != nil {
+		recipients = createStringListFromTFState(data.Definition.Recipients)
+	}
+	return api.NotificationChannelDefinition{
+		ApiKey:             data.Definition.ApiKey.ValueString(),
+		DagId:              data.Definition.DagId.ValueString(),
+		DeploymentApiToken: data.Definition.DeploymentApiToken.ValueString(),
+		DeploymentId:       data.Definition.DeploymentId.ValueString(),
+		IntegrationKey:     data.Definition.IntegrationKey.ValueString(),
+		Recipients:         recipients,
+		WebhookUrl:         data.Definition.WebhookUrl.ValueString(),
+	}
+}
+
+// loadOptionalString maps the empty string the API returns for unset fields to null.
+func loadOptionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func loadNotificationChannelResourceFromResponse(data *NotificationChannelResourceModel, channel *api.NotificationChannelResponse) {
+	// The secrets are write-only, the API doesn't return them either. Only their versions are kept.
+	definition := &NotificationChannelDefinitionModel{
+		ApiKey:                    types.StringNull(),
+		ApiKeyVersion:             types.Int64Null(),
+		DeploymentApiToken:        types.StringNull(),
+		DeploymentApiTokenVersion: types.Int64Null(),
+		IntegrationKey:            types.StringNull(),
+		IntegrationKeyVersion:     types.Int64Null(),
+		WebhookUrl:                types.StringNull(),
+		WebhookUrlVersion:         types.Int64Null(),
+	}
+	if data.Definition != nil {
+		definition.ApiKeyVersion = data.Definition.ApiKeyVersion
+		definition.DeploymentApiTokenVersion = data.Definition.DeploymentApiTokenVersion
+		definition.IntegrationKeyVersion = data.Definition.IntegrationKeyVersion
+		definition.WebhookUrlVersion = data.Definition.WebhookUrlVersion
+	}
+	definition.DagId = loadOptionalString(channel.Definition.DagId)
+	definition.DeploymentId = loadOptionalString(channel.Definition.DeploymentId)
+	if len(channel.Definition.Recipients) > 0 {
+		definition.Recipients = createTFStringListFromStrings(channel.Definition.Recipients)
+	}
+
+	data.CreatedAt = types.StringValue(channel.CreatedAt)
+	data.Definition = definition
+	data.EntityId = types.StringValue(channel.EntityId)
+	data.EntityType = types.StringValue(channel.EntityType)
+	data.Id = types.StringValue(channel.Id)
+	data.IsShared = types.BoolValue(channel.IsShared)
+	data.Name = types.StringValue(channel.Name)
+	data.Type = types.StringValue(channel.Type)
+	data.UpdatedAt = types.StringValue(channel.UpdatedAt)
+}
+
+func (r *NotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationChannelResourceModel
+	var config NotificationChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loadNotificationChannelSecretsFromConfig(&data, config)
+	resp.Diagnostics.Append(validateNotificationChannelDefinition(data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createRequest := &api.NotificationChannelCreateRequest{
+		Definition: createNotificationChannelDefinitionFromTFState(data),
+		EntityId:   data.EntityId.ValueString(),
+		EntityType: data.EntityType.ValueString(),
+		IsShared:   data.IsShared.ValueBool(),
+		Name:       data.Name.ValueString(),
+		Type:       data.Type.ValueString(),
+	}
+
+	channel, err := api.CreateNotificationChannel(r.token, r.organizationId, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification channel, got error: %s", err))
+		return
+	}
+
+	loadNotificationChannelResourceFromResponse(&data, channel)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationChannelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := api.GetNotificationChannel(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification channel, got error: %s", err))
+		return
+	}
+
+	loadNotificationChannelResourceFromResponse(&data, channel)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NotificationChannelResourceModel
+	var config NotificationChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loadNotificationChannelSecretsFromConfig(&data, config)
+	resp.Diagnostics.Append(validateNotificationChannelDefinition(data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateRequest := &api.NotificationChannelUpdateRequest{
+		Definition: createNotificationChannelDefinitionFromTFState(data),
+		IsShared:   data.IsShared.ValueBool(),
+		Name:       data.Name.ValueString(),
+		Type:       data.Type.ValueString(),
+	}
+
+	channel, err := api.UpdateNotificationChannel(r.token, r.organizationId, data.Id.ValueString(), updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification channel, got error: %s", err))
+		return
+	}
+
+	loadNotificationChannelResourceFromResponse(&data, channel)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationChannelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteNotificationChannel(r.token, r.organizationId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification channel, got error: %s", err))
+		return
+	}
+}
+
+func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
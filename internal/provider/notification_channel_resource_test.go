@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNotificationChannelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelResourceConfig("TestNotificationChannel"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_notification_channel.test", "name", "TestNotificationChannel"),
+					resource.TestCheckResourceAttr("astronomer_notification_channel.test", "type", "EMAIL"),
+					resource.TestCheckResourceAttr("astronomer_notification_channel.test", "is_shared", "false"),
+					resource.TestCheckResourceAttr("astronomer_notification_channel.test", "definition.recipients.#", "1"),
+					resource.TestCheckResourceAttrSet("astronomer_notification_channel.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "astronomer_notification_channel.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
+			{
+				Config: testAccNotificationChannelResourceConfig("TestNotificationChannelUpdate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_notification_channel.test", "name", "TestNotificationChannelUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationChannelResourceWriteOnly(t *testing.T) {
+	slackConfig := func(webhookUrlVersion int) string {
+		return strings.NewReplacer(
+			`type = "EMAIL"`, `type = "SLACK"`,
+			`recipients = ["tf-acc@example.com"]`, fmt.Sprintf("webhook_url = \"https://hooks.slack.com/services/T000/B000/TF_ACC_%[1]d\"\n\t\twebhook_url_version = %[1]d", webhookUrlVersion),
+		).Replace(testAccNotificationChannelResourceConfig("TestNotificationChannelSlack"))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: slackConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_notification_channel.test", "type", "SLACK"),
+					resource.TestCheckNoResourceAttr("astronomer_notification_channel.test", "definition.webhook_url"),
+					resource.TestCheckResourceAttr("astronomer_notification_channel.test", "definition.webhook_url_version", "1"),
+				),
+			},
+			{
+				Config: slackConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("astronomer_notification_channel.test", "definition.webhook_url"),
+					resource.TestCheckResourceAttr("astronomer_notification_channel.test", "definition.webhook_url_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationChannelResourceConfig(name string) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_workspace" "test" {
+	name = "TestNotificationChannelWorkspace"
+	cicd_enforced_default = true
+	description = "TestAccDataSource"
+}
+
+resource "astronomer_notification_channel" "test" {
+	name = %[2]q
+	type = "EMAIL"
+	entity_type = "WORKSPACE"
+	entity_id = astronomer_workspace.test.id
+	definition = {
+		recipients = ["tf-acc@example.com"]
+	}
+}
+`, orgId, name)
+}
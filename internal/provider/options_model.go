@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+type RangeModel struct {
+	Ceiling types.Int64 `tfsdk:"ceiling"`
+	Default types.Int64 `tfsdk:"default"`
+	Floor   types.Int64 `tfsdk:"floor"`
+}
+
+type ResourceRangeModel struct {
+	Ceiling types.String `tfsdk:"ceiling"`
+	Default types.String `tfsdk:"default"`
+	Floor   types.String `tfsdk:"floor"`
+}
+
+type ResourceOptionModel struct {
+	Cpu    *ResourceRangeModel `tfsdk:"cpu"`
+	Memory *ResourceRangeModel `tfsdk:"memory"`
+}
+
+type InstanceTypeModel struct {
+	Cpu    types.Int64  `tfsdk:"cpu"`
+	Memory types.String `tfsdk:"memory"`
+	Name   types.String `tfsdk:"name"`
+}
+
+func rangeDataSourceAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"ceiling": schema.Int64Attribute{
+				MarkdownDescription: "The highest allowed value.",
+				Computed:            true,
+			},
+			"default": schema.Int64Attribute{
+				MarkdownDescription: "The value used when none is given.",
+				Computed:            true,
+			},
+			"floor": schema.Int64Attribute{
+				MarkdownDescription: "The lowest allowed value.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: description,
+		Computed:            true,
+	}
+}
+
+func resourceRangeDataSourceAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"ceiling": schema.StringAttribute{
+				MarkdownDescription: "The highest allowed quantity.",
+				Computed:            true,
+			},
+			"default": schema.StringAttribute{
+				MarkdownDescription: "The quantity used when none is given.",
+				Computed:            true,
+			},
+			"floor": schema.StringAttribute{
+				MarkdownDescription: "The lowest allowed quantity.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: description,
+		Computed:            true,
+	}
+}
+
+func resourceOptionDataSourceAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"cpu":    resourceRangeDataSourceAttribute("The allowed CPU quantities, e.g. `0.5`."),
+			"memory": resourceRangeDataSourceAttribute("The allowed memory quantities, e.g. `1Gi`."),
+		},
+		MarkdownDescription: description,
+		Computed:            true,
+	}
+}
+
+func instanceTypesDataSourceAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"cpu": schema.Int64Attribute{
+					MarkdownDescription: "The instance type's number of CPUs.",
+					Computed:            true,
+				},
+				"memory": schema.StringAttribute{
+					MarkdownDescription: "The instance type's memory, e.g. `16Gi`.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The instance type's name.",
+					Computed:            true,
+				},
+			},
+		},
+		MarkdownDescription: description,
+		Computed:            true,
+	}
+}
+
+func loadRangeFromResponse(value api.Range) *RangeModel {
+	return &RangeModel{
+		Ceiling: types.Int64Value(int64(value.Ceiling)),
+		Default: types.Int64Value(int64(value.Default)),
+		Floor:   types.Int64Value(int64(value.Floor)),
+	}
+}
+
+func loadResourceOptionFromResponse(option api.ResourceOption) *ResourceOptionModel {
+	return &ResourceOptionModel{
+		Cpu: &ResourceRangeModel{
+			Ceiling: types.StringValue(option.Cpu.Ceiling),
+			Default: types.StringValue(option.Cpu.Default),
+			Floor:   types.StringValue(option.Cpu.Floor),
+		},
+		Memory: &ResourceRangeModel{
+			Ceiling: types.StringValue(option.Memory.Ceiling),
+			Default: types.StringValue(option.Memory.Default),
+			Floor:   types.StringValue(option.Memory.Floor),
+		},
+	}
+}
+
+func loadInstanceTypesFromResponse(instanceTypes []api.ProviderInstanceType) []InstanceTypeModel {
+	var models []InstanceTypeModel = []InstanceTypeModel{}
+	for _, instanceType := range instanceTypes {
+		models = append(models, InstanceTypeModel{
+			Cpu:    types.Int64Value(int64(instanceType.Cpu)),
+			Memory: types.StringValue(instanceType.Memory),
+			Name:   types.StringValue(instanceType.Name),
+		})
+	}
+	return models
+}
@@ -22,29 +22,20 @@ type OrgDataSource struct {
 }
 
 type OrgDataSourceModel struct {
-	BillingEmail types.String `tfsdk:"billing_email"`
-	CreatedAt    types.String `tfsdk:"created_at"`
-	// CreatedBy      BasicSubjectProfileModel `tfsdk:"created_by"`
-	Id             types.String         `tfsdk:"id"`
-	IsScimEnabled  types.Bool           `tfsdk:"is_scim_enabled"`
-	ManagedDomains []ManagedDomainModel `tfsdk:"managed_domains"`
-	Name           types.String         `tfsdk:"name"`
-	PaymentMethod  types.String         `tfsdk:"payment_method"`
-	Product        types.String         `tfsdk:"product"`
-	Status         types.String         `tfsdk:"status"`
-	SupportPlan    types.String         `tfsdk:"support_plan"`
-	TrialExpiresAt types.String         `tfsdk:"trial_expires_at"`
-	UpdatedAt      types.String         `tfsdk:"updated_at"`
-	// UpdatedBy      BasicSubjectProfileModel `tfsdk:"updated_by"`
-}
-
-type BasicSubjectProfileModel struct {
-	APITokenName types.String `tfsdk:"api_token_name"`
-	AvatarUrl    types.String `tfsdk:"avatar_url"`
-	FullName     types.String `tfsdk:"full_name"`
-	Id           types.String `tfsdk:"id"`
-	SubjectType  types.String `tfsdk:"subject_type"`
-	Username     types.String `tfsdk:"username"`
+	BillingEmail   types.String              `tfsdk:"billing_email"`
+	CreatedAt      types.String              `tfsdk:"created_at"`
+	CreatedBy      *BasicSubjectProfileModel `tfsdk:"created_by"`
+	Id             types.String              `tfsdk:"id"`
+	IsScimEnabled  types.Bool                `tfsdk:"is_scim_enabled"`
+	ManagedDomains []ManagedDomainModel      `tfsdk:"managed_domains"`
+	Name           types.String              `tfsdk:"name"`
+	PaymentMethod  types.String              `tfsdk:"payment_method"`
+	Product        types.String              `tfsdk:"product"`
+	Status         types.String              `tfsdk:"status"`
+	SupportPlan    types.String              `tfsdk:"support_plan"`
+	TrialExpiresAt types.String              `tfsdk:"trial_expires_at"`
+	UpdatedAt      types.String              `tfsdk:"updated_at"`
+	UpdatedBy      *BasicSubjectProfileModel `tfsdk:"updated_by"`
 }
 
 type ManagedDomainModel struct {
@@ -75,18 +66,7 @@ func (d *OrgDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: "Timestamped string of when this organization was created",
 				Computed:            true,
 			},
-			// "created_by": schema.ObjectAttribute{
-			// 	AttributeTypes: map[string]attr.Type{
-			// 		"api_token_name": types.StringType,
-			// 		"avatar_url":     types.StringType,
-			// 		"full_name":      types.StringType,
-			// 		"id":             types.StringType,
-			// 		"subject_type":   types.StringType,
-			// 		"username":       types.StringType,
-			// 	},
-			// 	MarkdownDescription: "Who created this organization.",
-			// 	Computed:            true,
-			// },
+			"created_by": basicSubjectProfileDataSourceAttribute("Who created this organization."),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Organization's unique identifier",
 				Required:            true,
@@ -150,18 +130,7 @@ func (d *OrgDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: "Last time the organization was updated",
 				Computed:            true,
 			},
-			// "updated_by": schema.ObjectAttribute{
-			// 	AttributeTypes: map[string]attr.Type{
-			// 		"api_token_name": types.StringType,
-			// 		"avatar_url":     types.StringType,
-			// 		"full_name":      types.StringType,
-			// 		"id":             types.StringType,
-			// 		"subject_type":   types.StringType,
-			// 		"username":       types.StringType,
-			// 	},
-			// 	MarkdownDescription: "Who last updated this organization.",
-			// 	Computed:            true,
-			// },
+			"updated_by": basicSubjectProfileDataSourceAttribute("Who last updated this organization."),
 		},
 	}
 }
@@ -205,7 +174,7 @@ func (d *OrgDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 
 	data.BillingEmail = types.StringValue(decoded.BillingEmail)
 	data.CreatedAt = types.StringValue(decoded.CreatedAt)
-	// data.CreatedBy = types.Object
+	data.CreatedBy = loadBasicSubjectProfileFromResponse(decoded.CreatedBy)
 	data.Id = types.StringValue(decoded.Id)
 	data.IsScimEnabled = types.BoolValue(decoded.IsScimEnabled)
 	data.ManagedDomains = loadManagedDomainsFromResponse(decoded)
@@ -216,7 +185,7 @@ func (d *OrgDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	data.SupportPlan = types.StringValue(decoded.SupportPlan)
 	data.TrialExpiresAt = types.StringValue(decoded.TrialExpiresAt)
 	data.UpdatedAt = types.StringValue(decoded.UpdatedAt)
-	// UpdatedBy      BasicSubjectProfile `tfsdk:"updated_by"`
+	data.UpdatedBy = loadBasicSubjectProfileFromResponse(decoded.UpdatedBy)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
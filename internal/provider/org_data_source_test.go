@@ -19,6 +19,8 @@ func TestOrgDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.astronomer_organization.test", "billing_email"),
 					resource.TestCheckResourceAttrSet("data.astronomer_organization.test", "id"),
 					resource.TestCheckResourceAttrSet("data.astronomer_organization.test", "name"),
+					resource.TestCheckResourceAttrSet("data.astronomer_organization.test", "created_by.id"),
+					resource.TestCheckResourceAttrSet("data.astronomer_organization.test", "updated_by.id"),
 				),
 			},
 		},
@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ resource.Resource = &OrgResource{}
+var _ resource.ResourceWithImportState = &OrgResource{}
+
+func NewOrgResource() resource.Resource {
+	return &OrgResource{}
+}
+
+type OrgResource struct {
+	token          string
+	organizationId string
+}
+
+type OrgResourceModel struct {
+	BillingEmail                      types.String                       `tfsdk:"billing_email"`
+	CreatedAt                         types.String                       `tfsdk:"created_at"`
+	DefaultDeploymentSettings         *OrgDefaultDeploymentSettingsModel `tfsdk:"default_deployment_settings"`
+	EnvironmentSecretsFetchingEnabled types.Bool                         `tfsdk:"environment_secrets_fetching_enabled"`
+	Id                                types.String                       `tfsdk:"id"`
+	IsScimEnabled                     types.Bool                         `tfsdk:"is_scim_enabled"`
+	Name                              types.String                       `tfsdk:"name"`
+	Product                           types.String                       `tfsdk:"product"`
+	Status                            types.String                       `tfsdk:"status"`
+	SupportPlan                       types.String                       `tfsdk:"support_plan"`
+	UpdatedAt                         types.String                       `tfsdk:"updated_at"`
+}
+
+type OrgDefaultDeploymentSettingsModel struct {
+	IsCicdEnforced     types.Bool `tfsdk:"is_cicd_enforced"`
+	IsDagDeployEnabled types.Bool `tfsdk:"is_dag_deploy_enabled"`
+	IsHighAvailability types.Bool `tfsdk:"is_high_availability"`
+}
+
+func (r *OrgResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (r *OrgResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The settings of the organization the provider is configured for. Organizations cannot be created or deleted through the API, so this resource adopts the existing organization on create and only removes it from state on destroy.",
+
+		Attributes: map[string]schema.Attribute{
+			"billing_email": schema.StringAttribute{
+				MarkdownDescription: "Billing email on file for the organization.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamped string of when this organization was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_deployment_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "The settings new Deployments in the organization start with. Left untouched when omitted.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"is_cicd_enforced": schema.BoolAttribute{
+						MarkdownDescription: "Whether new Deployments only accept code deploys from API tokens.",
+						Required:            true,
+					},
+					"is_dag_deploy_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether new Deployments have DAG deploys enabled.",
+						Required:            true,
+					},
+					"is_high_availability": schema.BoolAttribute{
+						MarkdownDescription: "Whether new Deployments run in high availability mode.",
+						Required:            true,
+					},
+				},
+			},
+			"environment_secrets_fetching_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the values of secret environment variables can be fetched through the API.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Organization's unique identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_scim_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not scim is enabled",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Organization's name",
+				Required:            true,
+			},
+			"product": schema.StringAttribute{
+				MarkdownDescription: "Type of astro product (e.g. hosted or hybrid)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the organization",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"support_plan": schema.StringAttribute{
+				MarkdownDescription: "Type of support plan the organization has",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last time the organization was updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *OrgResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderResourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderResourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.token = provider.Token
+	r.organizationId = provider.OrganizationId
+}
+
+// createOrgUpdateRequestFromTFState builds an update request from the plan, falling back to the
+// organization's current settings for any attribute Terraform does not manage.
+func createOrgUpdateRequestFromTFState(data OrgResourceModel, org *api.OrgResponse) *api.OrgUpdateRequest {
+	updateRequest := &api.OrgUpdateRequest{
+		BillingEmail:                      org.BillingEmail,
+		DefaultDeploymentSettings:         org.DefaultDeploymentSettings,
+		EnvironmentSecretsFetchingEnabled: org.EnvironmentSecretsFetchingEnabled,
+		IsScimEnabled:                     org.IsScimEnabled,
+		Name:                              data.Name.ValueString(),
+	}
+
+	if !data.BillingEmail.IsUnknown() && !data.BillingEmail.IsNull() {
+		updateRequest.BillingEmail = data.BillingEmail.ValueString()
+	}
+	if data.DefaultDeploymentSettings != nil {
+		updateRequest.DefaultDeploymentSettings = api.OrgDefaultDeploymentSettings{
+			IsCicdEnforced:     data.DefaultDeploymentSettings.IsCicdEnforced.ValueBool(),
+			IsDagDeployEnabled: data.DefaultDeploymentSettings.IsDagDeployEnabled.ValueBool(),
+			IsHighAvailability: data.DefaultDeploymentSettings.IsHighAvailability.ValueBool(),
+		}
+	}
+	if !data.EnvironmentSecretsFetchingEnabled.IsUnknown() && !data.EnvironmentSecretsFetchingEnabled.IsNull() {
+		updateRequest.EnvironmentSecretsFetchingEnabled = data.EnvironmentSecretsFetchingEnabled.ValueBool()
+	}
+	if !data.IsScimEnabled.IsUnknown() && !data.IsScimEnabled.IsNull() {
+		updateRequest.IsScimEnabled = data.IsScimEnabled.ValueBool()
+	}
+	return updateRequest
+}
+
+// loadOrgResourceFromResponse refreshes the model from the organization. The default deployment
+// settings are only loaded when Terraform manages them, or when the organization is being imported.
+func loadOrgResourceFromResponse(data *OrgResourceModel, org *api.OrgResponse) {
+	if data.DefaultDeploymentSettings != nil || data.Name.IsNull() {
+		data.DefaultDeploymentSettings = &OrgDefaultDeploymentSettingsModel{
+			IsCicdEnforced:     types.BoolValue(org.DefaultDeploymentSettings.IsCicdEnforced),
+			IsDagDeployEnabled: types.BoolValue(org.DefaultDeploymentSettings.IsDagDeployEnabled),
+			IsHighAvailability: types.BoolValue(org.DefaultDeploymentSettings.IsHighAvailability),
+		}
+	}
+	data.BillingEmail = types.StringValue(org.BillingEmail)
+	data.CreatedAt = types.StringValue(org.CreatedAt)
+	data.EnvironmentSecretsFetchingEnabled = types.BoolValue(org.EnvironmentSecretsFetchingEnabled)
+	data.Id = types.StringValue(org.Id)
+	data.IsScimEnabled = types.BoolValue(org.IsScimEnabled)
+	data.Name = types.StringValue(org.Name)
+	data.Product = types.StringValue(org.Product)
+	data.Status = types.StringValue(org.Status)
+	data.SupportPlan = types.StringValue(org.SupportPlan)
+	data.UpdatedAt = types.StringValue(org.UpdatedAt)
+}
+
+func (r *OrgResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrgResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org, err := api.GetOrg(r.token, r.organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Adopting existing organization", map[string]interface{}{"id": org.Id})
+
+	orgResponse, err := api.UpdateOrg(r.token, org.Id, createOrgUpdateRequestFromTFState(data, org))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization, got error: %s", err))
+		return
+	}
+
+	loadOrgResourceFromResponse(&data, orgResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrgResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org, err := api.GetOrg(r.token, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
+		return
+	}
+
+	loadOrgResourceFromResponse(&data, org)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrgResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org, err := api.GetOrg(r.token, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
+		return
+	}
+
+	orgResponse, err := api.UpdateOrg(r.token, data.Id.ValueString(), createOrgUpdateRequestFromTFState(data, org))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization, got error: %s", err))
+		return
+	}
+
+	loadOrgResourceFromResponse(&data, orgResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrgResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Organizations can't be deleted through the API, so the organization is only removed from state.
+	tflog.Info(ctx, "Removing organization from state, the organization itself is left untouched", map[string]interface{}{"id": data.Id.ValueString()})
+}
+
+func (r *OrgResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
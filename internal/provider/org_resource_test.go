@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrgResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrgResourceConfig("terraform_data.original_billing_email.output"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("astronomer_organization.test", "id", "data.astronomer_organization.test", "id"),
+					resource.TestCheckResourceAttrPair("astronomer_organization.test", "name", "data.astronomer_organization.test", "name"),
+					resource.TestCheckResourceAttrPair("astronomer_organization.test", "billing_email", "data.astronomer_organization.test", "billing_email"),
+					resource.TestCheckNoResourceAttr("astronomer_organization.test", "default_deployment_settings.%"),
+				),
+			},
+			{
+				Config: testAccOrgResourceConfig(`"tf-acc@gkconsulting.dev"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("astronomer_organization.test", "billing_email", "tf-acc@gkconsulting.dev"),
+				),
+			},
+			{
+				Config: testAccOrgResourceConfig("terraform_data.original_billing_email.output"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("astronomer_organization.test", "billing_email", "terraform_data.original_billing_email", "output"),
+				),
+			},
+			{
+				ResourceName:      "astronomer_organization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The default deployment settings aren't configured, but they're loaded on import.
+				ImportStateVerifyIgnore: []string{"updated_at", "default_deployment_settings"},
+			},
+		},
+	})
+}
+
+// testAccOrgResourceConfig sets the organization's billing email to the given expression. The
+// original billing email is kept in a terraform_data resource, so a later step can restore it.
+func testAccOrgResourceConfig(billingEmail string) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+data "astronomer_organization" "test" {
+	id = %[1]q
+}
+
+resource "terraform_data" "original_billing_email" {
+	input = data.astronomer_organization.test.billing_email
+
+	lifecycle {
+		ignore_changes = [input]
+	}
+}
+
+resource "astronomer_organization" "test" {
+	name          = data.astronomer_organization.test.name
+	billing_email = %[2]s
+}
+`, orgId, billingEmail)
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ datasource.DataSource = &PermissionGroupsDataSource{}
+
+func NewPermissionGroupsDataSource() datasource.DataSource {
+	return &PermissionGroupsDataSource{}
+}
+
+type PermissionGroupsDataSource struct {
+	token          string
+	organizationId string
+}
+
+type PermissionGroupsDataSourceModel struct {
+	PermissionGroups []PermissionGroupModel `tfsdk:"permission_groups"`
+	Permissions      []types.String         `tfsdk:"permissions"`
+	ScopeType        types.String           `tfsdk:"scope_type"`
+}
+
+type PermissionGroupModel struct {
+	Description types.String      `tfsdk:"description"`
+	Name        types.String      `tfsdk:"name"`
+	Permissions []PermissionModel `tfsdk:"permissions"`
+	Scope       types.String      `tfsdk:"scope"`
+}
+
+type PermissionModel struct {
+	Action      types.String `tfsdk:"action"`
+	Description types.String `tfsdk:"description"`
+	Name        types.String `tfsdk:"name"`
+}
+
+func (d *PermissionGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_groups"
+}
+
+func (d *PermissionGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The permissions that can be granted to custom roles of a scope type.",
+
+		Attributes: map[string]schema.Attribute{
+			"permission_groups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The permission group's description.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The permission group's name, e.g. `deployment.apiTokens`.",
+							Computed:            true,
+						},
+						"permissions": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"action": schema.StringAttribute{
+										MarkdownDescription: "The action the permission allows, e.g. `get`.",
+										Computed:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "The permission's description.",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The permission as used in `astronomer_custom_role`, e.g. `deployment.apiTokens.get`.",
+										Computed:            true,
+									},
+								},
+							},
+							MarkdownDescription: "The permissions of the group.",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "The scope the permission group applies to.",
+							Computed:            true,
+						},
+					},
+				},
+				MarkdownDescription: "The permission groups of the scope type.",
+				Computed:            true,
+			},
+			"permissions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Every permission of the scope type, as used in `astronomer_custom_role`.",
+				Computed:            true,
+			},
+			"scope_type": schema.StringAttribute{
+				MarkdownDescription: "The scope type to list permissions for. Defaults to `DEPLOYMENT`.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PermissionGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*AstronomerProviderDataSourceDataModel)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AstronomerProviderDataSourceDataModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.token = provider.Token
+	d.organizationId = provider.OrganizationId
+}
+
+func getPermissionName(group api.PermissionGroup, permission api.Permission) string {
+	return group.Name + "." + permission.Action
+}
+
+func getPermissionNames(groups []api.PermissionGroup) []string {
+	var names []string = []string{}
+	for _, group := range groups {
+		for _, permission := range group.Permissions {
+			names = append(names, getPermissionName(group, permission))
+		}
+	}
+	return names
+}
+
+func (d *PermissionGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ScopeType.IsNull() {
+		data.ScopeType = types.StringValue(api.RoleScopeTypeDeployment)
+	}
+
+	groups, err := api.GetPermissionGroups(d.token, d.organizationId, data.ScopeType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("ERROR: %s", err.Error()))
+		return
+	}
+
+	var permissionGroups []PermissionGroupModel = []PermissionGroupModel{}
+	for _, group := range groups {
+		var permissions []PermissionModel = []PermissionModel{}
+		for _, permission := range group.Permissions {
+			permissions = append(permissions, PermissionModel{
+				Action:      types.StringValue(permission.Action),
+				Description: types.StringValue(permission.Description),
+				Name:        types.StringValue(getPermissionName(group, permission)),
+			})
+		}
+		permissionGroups = append(permissionGroups, PermissionGroupModel{
+			Description: types.StringValue(group.Description),
+			Name:        types.StringValue(group.Name),
+			Permissions: permissions,
+			Scope:       types.StringValue(group.Scope),
+		})
+	}
+
+	data.PermissionGroups = permissionGroups
+	data.Permissions = createTFStringListFromStrings(getPermissionNames(groups))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
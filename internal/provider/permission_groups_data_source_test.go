@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPermissionGroupsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testPermissionGroupsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.astronomer_permission_groups.test", "scope_type", "DEPLOYMENT"),
+					resource.TestCheckResourceAttrSet("data.astronomer_permission_groups.test", "permission_groups.0.name"),
+					resource.TestCheckResourceAttrSet("data.astronomer_permission_groups.test", "permissions.0"),
+				),
+			},
+		},
+	})
+}
+
+func testPermissionGroupsDataSourceConfig() string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+data "astronomer_permission_groups" "test" {}
+`, orgId)
+}
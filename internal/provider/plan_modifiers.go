@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// runtimeVersionDowngradeRequiresReplace lets an Astro Runtime version be upgraded in place, but
+// replaces the resource when the version goes down, since Deployments can't be downgraded.
+type runtimeVersionDowngradeRequiresReplace struct{}
+
+func (m runtimeVersionDowngradeRequiresReplace) Description(ctx context.Context) string {
+	return "Downgrading the Astro Runtime version replaces the resource."
+}
+
+func (m runtimeVersionDowngradeRequiresReplace) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m runtimeVersionDowngradeRequiresReplace) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	current, err := version.NewVersion(req.StateValue.ValueString())
+	if err != nil {
+		return
+	}
+	planned, err := version.NewVersion(req.PlanValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Validation Error", fmt.Sprintf("%q is not an Astro Runtime version: %s", req.PlanValue.ValueString(), err))
+		return
+	}
+
+	if planned.LessThan(current) {
+		resp.RequiresReplace = true
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Deployment Will Be Replaced", fmt.Sprintf("Astro Runtime can't be downgraded in place from %s to %s, the Deployment will be destroyed and created again.", current, planned))
+	}
+}
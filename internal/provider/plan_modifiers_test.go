@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRuntimeVersionDowngradeRequiresReplace(t *testing.T) {
+	tests := []struct {
+		name            string
+		state           types.String
+		plan            types.String
+		requiresReplace bool
+		hasError        bool
+	}{
+		{
+			name:            "upgrade",
+			state:           types.StringValue("9.1.0"),
+			plan:            types.StringValue("9.2.0"),
+			requiresReplace: false,
+		},
+		{
+			name:            "downgrade",
+			state:           types.StringValue("9.2.0"),
+			plan:            types.StringValue("9.1.0"),
+			requiresReplace: true,
+		},
+		{
+			name:            "equal",
+			state:           types.StringValue("9.1.0"),
+			plan:            types.StringValue("9.1.0"),
+			requiresReplace: false,
+		},
+		{
+			name:            "create",
+			state:           types.StringNull(),
+			plan:            types.StringValue("9.1.0"),
+			requiresReplace: false,
+		},
+		{
+			name:            "unknown",
+			state:           types.StringValue("9.1.0"),
+			plan:            types.StringUnknown(),
+			requiresReplace: false,
+		},
+		{
+			name:            "unparsable plan",
+			state:           types.StringValue("9.1.0"),
+			plan:            types.StringValue("latest"),
+			requiresReplace: false,
+			hasError:        true,
+		},
+		{
+			name:            "unparsable state",
+			state:           types.StringValue("latest"),
+			plan:            types.StringValue("9.1.0"),
+			requiresReplace: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:       path.Root("astro_runtime_version"),
+				StateValue: test.state,
+				PlanValue:  test.plan,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: test.plan}
+			runtimeVersionDowngradeRequiresReplace{}.PlanModifyString(context.Background(), req, resp)
+
+			if resp.RequiresReplace != test.requiresReplace {
+				t.Errorf("expected RequiresReplace %t, got %t", test.requiresReplace, resp.RequiresReplace)
+			}
+			if resp.Diagnostics.HasError() != test.hasError {
+				t.Errorf("expected error %t, got %v", test.hasError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -93,8 +93,23 @@ func (p *AstronomerProvider) Configure(ctx context.Context, req provider.Configu
 
 func (p *AstronomerProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAlertResource,
+		NewAllowedIpAddressRangeResource,
 		NewClusterResource,
+		NewClusterNodePoolResource,
+		NewClusterPrivateEndpointResource,
+		NewClusterRouteResource,
+		NewClusterVpcPeeringResource,
+		NewCustomRoleResource,
 		NewDeploymentResource,
+		NewDeploymentApiTokenResource,
+		NewDeploymentEnvironmentVariableResource,
+		NewDeploymentHibernationOverrideResource,
+		NewDeploymentWorkerQueueResource,
+		NewIdentityProviderResource,
+		NewManagedDomainResource,
+		NewNotificationChannelResource,
+		NewOrgResource,
 		NewWorkspaceResource,
 	}
 }
@@ -104,8 +119,13 @@ func (p *AstronomerProvider) DataSources(ctx context.Context) []func() datasourc
 		NewWorkspaceDataSource,
 		// NewOrganizationDataSource,
 		NewClusterDataSource,
+		NewClusterOptionsDataSource,
 		NewDeploymentDataSource,
+		NewDeploymentOptionsDataSource,
 		NewOrgDataSource,
+		NewPermissionGroupsDataSource,
+		NewAuditLogsDataSource,
+		NewRuntimeReleasesDataSource,
 	}
 }
 
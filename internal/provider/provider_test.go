@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
@@ -20,3 +21,49 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("ORGANIZATION_ID must be set for acceptance tests")
 	}
 }
+
+// testAccDeploymentConfig returns the provider, a workspace and a small CELERY deployment named name,
+// for tests of resources that belong to a deployment. deploymentAttributes is added to the deployment
+// as is, one tab-indented attribute per line.
+func testAccDeploymentConfig(name string, deploymentAttributes string) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+resource "astronomer_workspace" "test" {
+	name = "TestAccDeploymentWorkspace"
+	cicd_enforced_default = true
+	description = "TestAccDataSource"
+}
+
+resource "astronomer_deployment" "test" {
+	astro_runtime_version = "9.1.0"
+	cloud_provider = "AWS"
+	default_task_pod_cpu = "0.5"
+	default_task_pod_memory = "1Gi"
+	description = "A Standard Deployment"
+	executor = "CELERY"
+	is_dag_deploy_enabled = true
+	is_cicd_enforced = true
+	is_high_availability = false
+	name = %[2]q
+	region = "us-east-1"
+	resource_quota_cpu = "160"
+	resource_quota_memory = "320Gi"
+	scheduler_size = "SMALL"
+	type = "STANDARD"
+	workspace_id = astronomer_workspace.test.id
+%[3]s	worker_queues = {
+		default = {
+		astro_machine:      "A5",
+		is_default:         true,
+		max_worker_count:    1,
+		min_worker_count:    1,
+		worker_concurrency: 1,
+		},
+	}
+}
+`, orgId, name, deploymentAttributes)
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	api "github.com/openglshaders/astronomer-api/v2"
+)
+
+var _ datasource.DataSource = &RuntimeReleasesDataSource{}
+
+func NewRuntimeReleasesDataSource() datasource.DataSource {
+	return &RuntimeReleasesDataSource{}
+}
+
+type RuntimeReleasesDataSource struct{}
+
+type RuntimeReleasesDataSourceModel struct {
+	LatestOnly        types.Bool            `tfsdk:"latest_only"`
+	LatestVersion     types.String          `tfsdk:"latest_version"`
+	Releases          []RuntimeReleaseModel `tfsdk:"releases"`
+	VersionConstraint types.String          `tfsdk:"version_constraint"`
+}
+
+type RuntimeReleaseModel struct {
+	AirflowVersion  types.String `tfsdk:"airflow_version"`
+	Channel         types.String `tfsdk:"channel"`
+	DeprecationDate types.String `tfsdk:"deprecation_date"`
+	EndOfSupport    types.String `tfsdk:"end_of_support"`
+	ReleaseDate     types.String `tfsdk:"release_date"`
+	Version         types.String `tfsdk:"version"`
+}
+
+func (d *RuntimeReleasesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runtime_releases"
+}
+
+func (d *RuntimeReleasesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The available Astro Runtime releases, newest first. Use `latest_version` with a `version_constraint` to pin a Deployment's `astro_runtime_version` to e.g. the latest 11.x release.",
+
+		Attributes: map[string]schema.Attribute{
+			"latest_only": schema.BoolAttribute{
+				MarkdownDescription: "Only return the newest release matching `version_constraint`.",
+				Optional:            true,
+			},
+			"latest_version": schema.StringAttribute{
+				MarkdownDescription: "The newest release matching `version_constraint`.",
+				Computed:            true,
+			},
+			"releases": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"airflow_version": schema.StringAttribute{
+							MarkdownDescription: "The Airflow version the release is based on.",
+							Computed:            true,
+						},
+						"channel": schema.StringAttribute{
+							MarkdownDescription: "The release channel, e.g. `stable` or `deprecated`.",
+							Computed:            true,
+						},
+						"deprecation_date": schema.StringAttribute{
+							MarkdownDescription: "When the release is deprecated, if announced.",
+							Computed:            true,
+						},
+						"end_of_support": schema.StringAttribute{
+							MarkdownDescription: "When support for the release ends, if announced.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "When the release was published.",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "The Astro Runtime version, as used in `astro_runtime_version`.",
+							Computed:            true,
+						},
+					},
+				},
+				MarkdownDescription: "The releases matching `version_constraint`, newest first.",
+				Computed:            true,
+			},
+			"version_constraint": schema.StringAttribute{
+				MarkdownDescription: "A version constraint in Terraform's syntax, e.g. `~> 11.0` or `>= 10.0, < 12.0`. Pre-releases only match constraints that name a pre-release.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (d *RuntimeReleasesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Runtime releases are public, so the provider's token and organization aren't needed
+}
+
+func (d *RuntimeReleasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RuntimeReleasesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a constraint every release matches, except pre-releases
+	constraint := ">= 0.0.0"
+	if !data.VersionConstraint.IsNull() {
+		constraint = data.VersionConstraint.ValueString()
+	}
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("version_constraint"), "Validation Error", fmt.Sprintf("Invalid version constraint: %s", err))
+		return
+	}
+
+	releases, err := api.GetRuntimeReleases()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("ERROR: %s", err.Error()))
+		return
+	}
+
+	// Skip versions that aren't semver, like the 3.0-1 style of the earliest releases
+	var versions []*version.Version
+	for key := range releases {
+		v, err := version.NewSemver(key)
+		if err != nil || !constraints.Check(v) {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	sort.Sort(sort.Reverse(version.Collection(versions)))
+
+	if len(versions) == 0 {
+		resp.Diagnostics.AddError("No Matching Release", fmt.Sprintf("No Astro Runtime release matches %q", constraint))
+		return
+	}
+	if data.LatestOnly.ValueBool() {
+		versions = versions[:1]
+	}
+
+	var runtimeReleases []RuntimeReleaseModel = []RuntimeReleaseModel{}
+	for _, v := range versions {
+		release := releases[v.Original()]
+		runtimeReleases = append(runtimeReleases, RuntimeReleaseModel{
+			AirflowVersion:  types.StringValue(release.Metadata.AirflowVersion),
+			Channel:         types.StringValue(release.Metadata.Channel),
+			DeprecationDate: loadOptionalString(release.Metadata.DeprecationDate),
+			EndOfSupport:    loadOptionalString(release.Metadata.EndOfSupport),
+			ReleaseDate:     types.StringValue(release.Metadata.ReleaseDate),
+			Version:         types.StringValue(v.Original()),
+		})
+	}
+
+	data.LatestVersion = types.StringValue(versions[0].Original())
+	data.Releases = runtimeReleases
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
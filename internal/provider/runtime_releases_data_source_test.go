@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRuntimeReleasesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testRuntimeReleasesDataSourceConfig("not a constraint", false),
+				ExpectError: regexp.MustCompile(`Invalid version constraint`),
+			},
+			{
+				Config: testRuntimeReleasesDataSourceConfig("~> 9.1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.astronomer_runtime_releases.test", "releases.#", "1"),
+					resource.TestMatchResourceAttr("data.astronomer_runtime_releases.test", "latest_version", regexp.MustCompile(`^9\.\d+\.\d+$`)),
+					resource.TestCheckResourceAttrPair("data.astronomer_runtime_releases.test", "latest_version", "data.astronomer_runtime_releases.test", "releases.0.version"),
+					resource.TestCheckResourceAttrSet("data.astronomer_runtime_releases.test", "releases.0.airflow_version"),
+				),
+			},
+			{
+				Config: testRuntimeReleasesDataSourceConfig("9.1.0", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.astronomer_runtime_releases.test", "releases.#", "1"),
+					resource.TestCheckResourceAttr("data.astronomer_runtime_releases.test", "latest_version", "9.1.0"),
+				),
+			},
+		},
+	})
+}
+
+func testRuntimeReleasesDataSourceConfig(versionConstraint string, latestOnly bool) string {
+	orgId := os.Getenv("ORGANIZATION_ID")
+	return fmt.Sprintf(`
+provider "astronomer" {
+	organization_id = %[1]q
+}
+
+data "astronomer_runtime_releases" "test" {
+	version_constraint = %[2]q
+	latest_only = %[3]t
+}
+`, orgId, versionConstraint, latestOnly)
+}
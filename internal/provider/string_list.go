@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// loadTFStringListKeepingOrder returns current when it holds the same values, so a list the API
+// returns in another order doesn't show up as a change.
+func loadTFStringListKeepingOrder(current []types.String, values []string) []types.String {
+	currentValues := createStringListFromTFState(current)
+	slices.Sort(currentValues)
+	sortedValues := slices.Clone(values)
+	slices.Sort(sortedValues)
+	if slices.Equal(currentValues, sortedValues) {
+		return current
+	}
+	return createTFStringListFromStrings(values)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLoadTFStringListKeepingOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  []types.String
+		values   []string
+		expected []types.String
+	}{
+		{
+			name:     "null and empty",
+			current:  nil,
+			values:   []string{},
+			expected: nil,
+		},
+		{
+			name:     "same order",
+			current:  []types.String{types.StringValue("a"), types.StringValue("b")},
+			values:   []string{"a", "b"},
+			expected: []types.String{types.StringValue("a"), types.StringValue("b")},
+		},
+		{
+			name:     "other order",
+			current:  []types.String{types.StringValue("b"), types.StringValue("a")},
+			values:   []string{"a", "b"},
+			expected: []types.String{types.StringValue("b"), types.StringValue("a")},
+		},
+		{
+			name:     "changed",
+			current:  []types.String{types.StringValue("b"), types.StringValue("a")},
+			values:   []string{"c", "a"},
+			expected: []types.String{types.StringValue("c"), types.StringValue("a")},
+		},
+		{
+			name:     "duplicates",
+			current:  []types.String{types.StringValue("a")},
+			values:   []string{"a", "a"},
+			expected: []types.String{types.StringValue("a"), types.StringValue("a")},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := loadTFStringListKeepingOrder(test.current, test.values); !reflect.DeepEqual(got, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// cidrValidator checks that a string is a CIDR block in its canonical form, e.g. `10.0.0.0/16`
+// rather than `10.0.0.1/16`, so the value the API stores matches the configuration.
+type cidrValidator struct{}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	return "value must be a CIDR block, e.g. 10.0.0.0/16"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a CIDR block, e.g. `10.0.0.0/16`"
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, ipNet, err := net.ParseCIDR(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Validation Error", fmt.Sprintf("%q is not a valid CIDR block: %s", req.ConfigValue.ValueString(), err))
+		return
+	}
+	if ipNet.String() != req.ConfigValue.ValueString() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Validation Error", fmt.Sprintf("%q has host bits set, use %q instead.", req.ConfigValue.ValueString(), ipNet.String()))
+	}
+}
+
+var cpuQuantityRegex = regexp.MustCompile(`^\d+(\.\d+)?$`)
+var memoryQuantityRegex = regexp.MustCompile(`^(\d+(\.\d+)?)(Mi|Gi)$`)
+
+// parseCpuQuantity returns the number of CPUs of a quantity such as `0.5` or `2`.
+func parseCpuQuantity(value string) (float64, error) {
+	if !cpuQuantityRegex.MatchString(value) {
+		return 0, fmt.Errorf("%q is not a CPU quantity, e.g. 0.5 or 2", value)
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// parseMemoryQuantity returns the Gi of a quantity such as `1Gi` or `512Mi`.
+func parseMemoryQuantity(value string) (float64, error) {
+	match := memoryQuantityRegex.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("%q is not a memory quantity, e.g. 1Gi or 512Mi", value)
+	}
+	quantity, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, err
+	}
+	if match[3] == "Mi" {
+		quantity = quantity / 1024
+	}
+	return quantity, nil
+}
+
+type cpuQuantityValidator struct{}
+
+func (v cpuQuantityValidator) Description(ctx context.Context) string {
+	return "value must be a CPU quantity, e.g. 0.5 or 2"
+}
+
+func (v cpuQuantityValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a CPU quantity, e.g. `0.5` or `2`"
+}
+
+func (v cpuQuantityValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseCpuQuantity(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Validation Error", err.Error())
+	}
+}
+
+type memoryQuantityValidator struct{}
+
+func (v memoryQuantityValidator) Description(ctx context.Context) string {
+	return "value must be a memory quantity, e.g. 1Gi or 512Mi"
+}
+
+func (v memoryQuantityValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a memory quantity, e.g. `1Gi` or `512Mi`"
+}
+
+func (v memoryQuantityValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseMemoryQuantity(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Validation Error", err.Error())
+	}
+}
+
+// memoryTwiceCpuValidator checks that a memory quantity is twice the CPU quantity of the sibling
+// attribute cpuAttribute, e.g. `1Gi` for `0.5`, as the API requires for task pods and quotas.
+type memoryTwiceCpuValidator struct {
+	cpuAttribute string
+}
+
+func (v memoryTwiceCpuValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be twice %s in Gi", v.cpuAttribute)
+}
+
+func (v memoryTwiceCpuValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be twice `%s` in Gi", v.cpuAttribute)
+}
+
+func (v memoryTwiceCpuValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var cpu types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(v.cpuAttribute), &cpu)...)
+	if resp.Diagnostics.HasError() || cpu.IsNull() || cpu.IsUnknown() {
+		return
+	}
+
+	// Malformed quantities are reported by the quantity validators
+	cpuQuantity, err := parseCpuQuantity(cpu.ValueString())
+	if err != nil {
+		return
+	}
+	memoryQuantity, err := parseMemoryQuantity(req.ConfigValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	if math.Abs(memoryQuantity-2*cpuQuantity) > 1e-9 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Validation Error",
+			fmt.Sprintf("%s must be twice %s: %s CPU needs %sGi of memory, got %s.", req.Path, v.cpuAttribute, cpu.ValueString(), strconv.FormatFloat(2*cpuQuantity, 'f', -1, 64), req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -22,10 +22,12 @@ type WorkspaceDataSource struct {
 }
 
 type WorkspaceDataSourceModel struct {
-	Id                  types.String `tfsdk:"id"`
-	CicdEnforcedDefault types.Bool   `tfsdk:"cicd_enforced_default"`
-	Description         types.String `tfsdk:"description"`
-	Name                types.String `tfsdk:"name"`
+	Id                  types.String              `tfsdk:"id"`
+	CicdEnforcedDefault types.Bool                `tfsdk:"cicd_enforced_default"`
+	CreatedBy           *BasicSubjectProfileModel `tfsdk:"created_by"`
+	Description         types.String              `tfsdk:"description"`
+	Name                types.String              `tfsdk:"name"`
+	UpdatedBy           *BasicSubjectProfileModel `tfsdk:"updated_by"`
 }
 
 func (d *WorkspaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,10 +51,12 @@ func (d *WorkspaceDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				MarkdownDescription: "Whether new Deployments enforce CI/CD deploys by default.",
 				Computed:            true,
 			},
+			"created_by": basicSubjectProfileDataSourceAttribute("Who created this Workspace."),
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The Workspace's description",
 				Computed:            true,
 			},
+			"updated_by": basicSubjectProfileDataSourceAttribute("Who last updated this Workspace."),
 		},
 	}
 }
@@ -95,8 +99,10 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	data.Id = types.StringValue(decoded.Id)
 	data.CicdEnforcedDefault = types.BoolValue(decoded.CicdEnforcedDefault)
+	data.CreatedBy = loadBasicSubjectProfileFromUser(decoded.CreatedBy)
 	data.Description = types.StringValue(decoded.Description)
 	data.Name = types.StringValue(decoded.Name)
+	data.UpdatedBy = loadBasicSubjectProfileFromUser(decoded.UpdatedBy)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
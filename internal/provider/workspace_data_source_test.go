@@ -19,6 +19,8 @@ func TestWorkspaceDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.astronomer_workspace.test", "name", "Data Source Test Workspace"),
 					resource.TestCheckResourceAttr("data.astronomer_workspace.test", "cicd_enforced_default", "true"),
 					resource.TestCheckResourceAttr("data.astronomer_workspace.test", "description", "TestAccDataSource"),
+					resource.TestCheckResourceAttrSet("data.astronomer_workspace.test", "created_by.id"),
+					resource.TestCheckResourceAttrSet("data.astronomer_workspace.test", "updated_by.id"),
 				),
 			},
 		},